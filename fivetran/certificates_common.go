@@ -171,6 +171,11 @@ func resourceCertificatesRead(ctx context.Context, d *schema.ResourceData, m int
 	id := d.Get("id").(string)
 	response, err := fetchCertificates(ctx, m.(*fivetran.Client), id, resourceType)
 	if err != nil {
+		// If the connector or destination does not exist (404), inform Terraform.
+		if helpers.IsNotFound(response.Code, err) {
+			d.SetId("")
+			return nil
+		}
 		return fmt.Errorf("%v; code: %v; message: %v", err, response.Code, response.Message)
 	}
 
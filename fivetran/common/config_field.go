@@ -15,6 +15,11 @@ type ConfigField struct {
 	ItemType       map[string]FieldValueType `json:"item_type"`
 	Description    map[string]string         `json:"description"`
 	ApiField       string                    `json:"api_field"`
+	Required       map[string]bool           `json:"required,omitempty"`
+	Enum           map[string][]string       `json:"enum,omitempty"`
+	Default        map[string]string         `json:"default,omitempty"`
+	Minimum        map[string]int64          `json:"minimum,omitempty"`
+	Maximum        map[string]int64          `json:"maximum,omitempty"`
 }
 
 func NewconfigField() ConfigField {
@@ -58,6 +63,10 @@ func GetFieldsForService(service string) map[string]ConfigField {
 	if r, ok := configFieldsByService[service]; ok {
 		return r
 	}
+	// service could have no config fields except destination schema ones
+	if _, ok := destinationSchemaFields[service]; ok {
+		return map[string]ConfigField{}
+	}
 	panic("Unknown service" + service)
 }
 
@@ -156,12 +165,7 @@ func readDestinationFieldsFromJson(target *map[string]ConfigField) {
 
 func handleDestinationSchemaField(fieldName string) {
 	if schema, ok := configFields[fieldName]; ok {
-		for k := range schema.Description {
-			if _, ok := destinationSchemaFields[k]; !ok {
-				destinationSchemaFields[k] = make(map[string]bool)
-			}
-			destinationSchemaFields[k][fieldName] = true
-		}
+		addDestinationSchemaField(fieldName, schema)
 		delete(configFields, fieldName)
 	}
 }
@@ -0,0 +1,27 @@
+package common
+
+import (
+	"testing"
+)
+
+func TestGetFieldsForService(t *testing.T) {
+	LoadConfigFieldsMap()
+	LoadAuthFieldsMap()
+	LocaDestinationFieldsMap()
+
+	if _, ok := GetFieldsForService("google_ads")["customer_id"]; !ok {
+		t.Errorf("config field should be available for the service")
+	}
+
+	// slack has only destination schema fields, they are not included in config fields
+	if fields := GetFieldsForService("slack"); len(fields) != 0 {
+		t.Errorf("service without config fields should have no fields, got %v", fields)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("unknown service should panic")
+		}
+	}()
+	GetFieldsForService("unknown_service")
+}
@@ -0,0 +1,186 @@
+package common
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"sync"
+)
+
+var (
+	fieldDefinitionsOnce sync.Once
+	fieldDefinitionsErr  error
+
+	// fieldDefinitionsMutex serializes merges of the field definitions files into the fields maps
+	fieldDefinitionsMutex  sync.Mutex
+	mergedFieldDefinitions = make(map[string]fieldDefinitionsResult)
+)
+
+type fieldDefinitionsResult struct {
+	skipped []string
+	err     error
+}
+
+// FieldDefinitions is the format of the field definitions override file:
+// each section has the same format as the embedded fields.json, auth-fields.json and destination-fields.json files
+type FieldDefinitions struct {
+	Config      map[string]json.RawMessage `json:"config"`
+	Auth        map[string]json.RawMessage `json:"auth"`
+	Destination map[string]json.RawMessage `json:"destination"`
+}
+
+// LoadFieldDefinitionsFromEnv merges field definitions from the file set in FIVETRAN_FIELD_DEFINITIONS_PATH
+// environment variable over the loaded fields maps. The file is merged once per process, before the provider
+// schema is built, concurrent and subsequent calls wait for the merge and return its result.
+func LoadFieldDefinitionsFromEnv() error {
+	fieldDefinitionsOnce.Do(func() {
+		if path := os.Getenv("FIVETRAN_FIELD_DEFINITIONS_PATH"); path != "" {
+			fieldDefinitionsMutex.Lock()
+			defer fieldDefinitionsMutex.Unlock()
+			_, fieldDefinitionsErr = mergeFieldDefinitionsFile(path, false)
+		}
+	})
+	return fieldDefinitionsErr
+}
+
+// MergeFieldDefinitionsFile merges field definitions from the file set in the provider configuration over the loaded fields maps.
+// The provider schema is already reported to Terraform when the provider is configured, so new fields and type changes
+// of existing fields are not merged: they change the shape of config objects in the schema. Names of the skipped fields are returned.
+// Each file is merged once per process, subsequent calls return the result of the first merge.
+func MergeFieldDefinitionsFile(path string) ([]string, error) {
+	fieldDefinitionsMutex.Lock()
+	defer fieldDefinitionsMutex.Unlock()
+
+	if result, ok := mergedFieldDefinitions[path]; ok {
+		return result.skipped, result.err
+	}
+	skipped, err := mergeFieldDefinitionsFile(path, true)
+	mergedFieldDefinitions[path] = fieldDefinitionsResult{skipped: skipped, err: err}
+	return skipped, err
+}
+
+func mergeFieldDefinitionsFile(path string, keepShape bool) ([]string, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	definitions := FieldDefinitions{}
+	if err := json.Unmarshal(content, &definitions); err != nil {
+		return nil, fmt.Errorf("can't parse field definitions file %v: %v", path, err)
+	}
+	return mergeFieldDefinitions(definitions, keepShape)
+}
+
+// mergeFieldDefinitions merges the field definitions over the loaded fields maps.
+// Definitions of existing fields are merged property by property: per-service values are added or replaced,
+// nested `fields` are replaced by field name. When keepShape is true, new fields and type changes are skipped.
+func mergeFieldDefinitions(definitions FieldDefinitions, keepShape bool) ([]string, error) {
+	skipped := []string{}
+
+	// destination schema fields are not a part of config, they define the list of the available services
+	for _, fn := range []string{"schema", "table", "schema_prefix"} {
+		if raw, ok := definitions.Config[fn]; ok {
+			field := ConfigField{}
+			if err := json.Unmarshal(raw, &field); err != nil {
+				return nil, fmt.Errorf("config field `%v`: %v", fn, err)
+			}
+			addDestinationSchemaField(fn, field)
+			delete(definitions.Config, fn)
+		}
+	}
+
+	sections := []struct {
+		name        string
+		target      map[string]ConfigField
+		definitions map[string]json.RawMessage
+	}{
+		{"config", configFields, definitions.Config},
+		{"auth", authFields, definitions.Auth},
+		{"destination", destinationFields, definitions.Destination},
+	}
+	for _, s := range sections {
+		names, err := mergeFields(s.name, s.target, s.definitions, keepShape)
+		if err != nil {
+			return nil, err
+		}
+		skipped = append(skipped, names...)
+	}
+
+	fillFieldsByService()
+	fillAuthFieldsByService()
+	fillDestinationFieldsByService()
+	return skipped, nil
+}
+
+func mergeFields(section string, target map[string]ConfigField, definitions map[string]json.RawMessage, keepShape bool) ([]string, error) {
+	skipped := []string{}
+	names := make([]string, 0, len(definitions))
+	for fn := range definitions {
+		names = append(names, fn)
+	}
+	sort.Strings(names)
+
+	for _, fn := range names {
+		existing, exists := target[fn]
+		field := ConfigField{}
+		if exists {
+			// work on a copy: maps of the existing definition should stay untouched if the field is skipped or invalid
+			content, err := json.Marshal(existing)
+			if err != nil {
+				return nil, fmt.Errorf("%v field `%v`: %v", section, fn, err)
+			}
+			if err := json.Unmarshal(content, &field); err != nil {
+				return nil, fmt.Errorf("%v field `%v`: %v", section, fn, err)
+			}
+		}
+		if err := json.Unmarshal(definitions[fn], &field); err != nil {
+			return nil, fmt.Errorf("%v field `%v`: %v", section, fn, err)
+		}
+		if err := validateFieldDefinition(field); err != nil {
+			return nil, fmt.Errorf("%v field `%v`: %v", section, fn, err)
+		}
+		if keepShape && (!exists || !sameFieldShape(existing, field)) {
+			skipped = append(skipped, section+"."+fn)
+			continue
+		}
+		target[fn] = field
+	}
+	return skipped, nil
+}
+
+func validateFieldDefinition(field ConfigField) error {
+	if field.FieldValueType == Unknown {
+		return fmt.Errorf("unknown field type")
+	}
+	for fn, f := range field.ItemFields {
+		if err := validateFieldDefinition(f); err != nil {
+			return fmt.Errorf("nested field `%v`: %v", fn, err)
+		}
+	}
+	return nil
+}
+
+// sameFieldShape checks that both definitions are represented with the same value type in the provider schema
+func sameFieldShape(a, b ConfigField) bool {
+	if a.FieldValueType != b.FieldValueType || len(a.ItemFields) != len(b.ItemFields) {
+		return false
+	}
+	for fn, af := range a.ItemFields {
+		bf, ok := b.ItemFields[fn]
+		if !ok || !sameFieldShape(af, bf) {
+			return false
+		}
+	}
+	return true
+}
+
+// addDestinationSchemaField registers the destination schema field for the services listed in its description
+func addDestinationSchemaField(fieldName string, field ConfigField) {
+	for service := range field.Description {
+		if _, ok := destinationSchemaFields[service]; !ok {
+			destinationSchemaFields[service] = make(map[string]bool)
+		}
+		destinationSchemaFields[service][fieldName] = true
+	}
+}
@@ -0,0 +1,127 @@
+package common
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+)
+
+const fieldDefinitionsJson = `
+{
+	"config": {
+		"schema": {
+			"type": "string",
+			"description": {"custom_service": "Destination schema name."}
+		},
+		"port": {
+			"description": {"custom_service": "The port number."}
+		},
+		"custom_field": {
+			"type": "string",
+			"description": {"custom_service": "The field that is not embedded into the provider."}
+		}
+	}
+}
+`
+
+// restoreFieldsMapsOnCleanup loads the embedded fields maps and restores them after the test:
+// merged field definitions should not leak into other tests of the package
+func restoreFieldsMapsOnCleanup(t *testing.T) {
+	LoadConfigFieldsMap()
+	LoadAuthFieldsMap()
+	LocaDestinationFieldsMap()
+
+	copyFields := func(source map[string]ConfigField) map[string]ConfigField {
+		result := make(map[string]ConfigField, len(source))
+		for k, v := range source {
+			result[k] = v
+		}
+		return result
+	}
+	config, auth, destination := copyFields(configFields), copyFields(authFields), copyFields(destinationFields)
+	schemaFields := make(map[string]map[string]bool, len(destinationSchemaFields))
+	for service, fields := range destinationSchemaFields {
+		schemaFields[service] = make(map[string]bool, len(fields))
+		for fn, v := range fields {
+			schemaFields[service][fn] = v
+		}
+	}
+
+	t.Cleanup(func() {
+		configFields, authFields, destinationFields = config, auth, destination
+		destinationSchemaFields = schemaFields
+		fillFieldsByService()
+		fillAuthFieldsByService()
+		fillDestinationFieldsByService()
+
+		fieldDefinitionsOnce = sync.Once{}
+		fieldDefinitionsErr = nil
+		mergedFieldDefinitions = make(map[string]fieldDefinitionsResult)
+	})
+}
+
+func writeFieldDefinitionsForTest(t *testing.T) string {
+	path := filepath.Join(t.TempDir(), "fields.json")
+	if err := os.WriteFile(path, []byte(fieldDefinitionsJson), 0600); err != nil {
+		t.Fatalf("unable to write field definitions file: %v", err)
+	}
+	return path
+}
+
+func TestLoadFieldDefinitionsFromEnv(t *testing.T) {
+	restoreFieldsMapsOnCleanup(t)
+
+	path := writeFieldDefinitionsForTest(t)
+	t.Setenv("FIVETRAN_FIELD_DEFINITIONS_PATH", path)
+
+	if err := LoadFieldDefinitionsFromEnv(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	fields := GetFieldsForService("custom_service")
+	if field, ok := fields["custom_field"]; !ok || field.FieldValueType != String {
+		t.Errorf("new field should be added for the service, got %v", fields)
+	}
+	if field, ok := fields["port"]; !ok || field.FieldValueType != Integer {
+		t.Errorf("existing field should be available for the service with the embedded type, got %v", fields)
+	}
+	if _, ok := GetFieldsForService("postgres")["port"]; !ok {
+		t.Errorf("existing field should stay available for the embedded services")
+	}
+	if !GetDestinationSchemaFields()["custom_service"]["schema"] {
+		t.Errorf("destination schema field should be registered for the service")
+	}
+
+	// the definitions are merged once per process, the variable is not read again
+	t.Setenv("FIVETRAN_FIELD_DEFINITIONS_PATH", filepath.Join(t.TempDir(), "missing.json"))
+	if err := LoadFieldDefinitionsFromEnv(); err != nil {
+		t.Errorf("definitions should not be merged again, got error: %v", err)
+	}
+}
+
+func TestMergeFieldDefinitionsFile(t *testing.T) {
+	restoreFieldsMapsOnCleanup(t)
+
+	path := writeFieldDefinitionsForTest(t)
+
+	skipped, err := MergeFieldDefinitionsFile(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(skipped) != 1 || skipped[0] != "config.custom_field" {
+		t.Errorf("new field should be skipped, got %v", skipped)
+	}
+
+	fields := GetFieldsForService("custom_service")
+	if _, ok := fields["custom_field"]; ok {
+		t.Errorf("new field should not be added after the provider schema is loaded")
+	}
+	if field, ok := fields["port"]; !ok || field.Description["custom_service"] != "The port number." {
+		t.Errorf("description of existing field should be merged, got %v", fields)
+	}
+
+	if _, err := MergeFieldDefinitionsFile(filepath.Join(t.TempDir(), "missing.json")); err == nil {
+		t.Errorf("missing file should be reported")
+	}
+}
@@ -0,0 +1,141 @@
+package fivetran
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/fivetran/go-fivetran"
+	"github.com/fivetran/go-fivetran/connectors"
+	"github.com/fivetran/go-fivetran/groups"
+	"github.com/fivetran/terraform-provider-fivetran/modules/helpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceConnectors() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceConnectorsRead,
+		Schema: map[string]*schema.Schema{
+			"service": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Optional filter. When defined, the data source will only contain connectors of the specified service type.",
+			},
+			"group_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Optional filter. When defined, the data source will only contain connectors of the specified group.",
+			},
+			"setup_state": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Optional filter. When defined, the data source will only contain connectors with the specified setup state. The available values are: `incomplete`, `connected`, `broken`.",
+			},
+			"sync_state": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Optional filter. When defined, the data source will only contain connectors with the specified sync state. The available values are: `scheduled`, `syncing`, `paused`, `rescheduled`.",
+			},
+			"paused": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Optional filter. When defined, the data source will only contain paused (`true`) or active (`false`) connectors.",
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+				Description:  "Optional filter. When defined, the data source will only contain connectors whose name (`schema`) matches the specified regular expression.",
+			},
+			"connectors": dataSourceGroupConnectorsSchemaConnectors(),
+		},
+	}
+}
+
+type connectorsFilter struct {
+	service    string
+	setupState string
+	syncState  string
+	paused     *bool
+	nameRegex  *regexp.Regexp
+}
+
+func (f connectorsFilter) match(connector connectors.DetailsResponseDataCommon) bool {
+	if f.service != "" && connector.Service != f.service {
+		return false
+	}
+	if f.setupState != "" && connector.Status.SetupState != f.setupState {
+		return false
+	}
+	if f.syncState != "" && connector.Status.SyncState != f.syncState {
+		return false
+	}
+	if f.paused != nil && (connector.Paused != nil && *connector.Paused) != *f.paused {
+		return false
+	}
+	if f.nameRegex != nil && !f.nameRegex.MatchString(connector.Schema) {
+		return false
+	}
+	return true
+}
+
+func dataSourceConnectorsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	client := m.(*fivetran.Client)
+
+	filter := connectorsFilter{
+		service:    d.Get("service").(string),
+		setupState: d.Get("setup_state").(string),
+		syncState:  d.Get("sync_state").(string),
+	}
+
+	if paused := d.GetRawConfig().GetAttr("paused"); !paused.IsNull() {
+		value := d.Get("paused").(bool)
+		filter.paused = &value
+	}
+
+	if nameRegex := d.Get("name_regex").(string); nameRegex != "" {
+		re, err := regexp.Compile(nameRegex)
+		if err != nil {
+			return helpers.NewDiagAppend(diags, diag.Error, "name_regex error", fmt.Sprint(err))
+		}
+		filter.nameRegex = re
+	}
+
+	groupIds := []string{}
+	if groupId := d.Get("group_id").(string); groupId != "" {
+		groupIds = append(groupIds, groupId)
+	} else {
+		groupsResp, err := dataSourceGroupsGetGroups(client, ctx)
+		if err != nil {
+			return helpers.NewDiagAppend(diags, diag.Error, "service error", fmt.Sprintf("%v; code: %v; message: %v", err, groupsResp.Code, groupsResp.Message))
+		}
+		for _, group := range groupsResp.Data.Items {
+			groupIds = append(groupIds, group.ID)
+		}
+	}
+
+	var resp groups.GroupListConnectorsResponse
+	for _, groupId := range groupIds {
+		groupResp, err := dataSourceGroupConnectorsGetConnectors(client, groupId, "", ctx)
+		if err != nil {
+			return helpers.NewDiagAppend(diags, diag.Error, "service error", fmt.Sprintf("%v; code: %v; message: %v", err, groupResp.Code, groupResp.Message))
+		}
+		for _, connector := range groupResp.Data.Items {
+			if filter.match(connector) {
+				resp.Data.Items = append(resp.Data.Items, connector)
+			}
+		}
+	}
+
+	if err := d.Set("connectors", dataSourceGroupConnectorsFlattenConnectors(&resp)); err != nil {
+		return helpers.NewDiagAppend(diags, diag.Error, "set error", fmt.Sprint(err))
+	}
+
+	// Enforces ID
+	d.SetId("0")
+
+	return diags
+}
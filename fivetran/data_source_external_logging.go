@@ -1,10 +0,0 @@
-package fivetran
-
-import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-
-func dataSourceExternalLogging() *schema.Resource {
-    return &schema.Resource{
-        ReadContext: resourceExternalLoggingRead,
-        Schema:      getExternalLoggingSchema(true),
-    }
-}
\ No newline at end of file
@@ -94,6 +94,11 @@ func dataSourceGroupConnectorsSchemaConnectors() *schema.Schema {
 					Computed:    true,
 					Description: "The connector schedule configuration type. Supported values: auto, manual",
 				},
+				"paused": {
+					Type:        schema.TypeBool,
+					Computed:    true,
+					Description: "Specifies whether the connector is paused",
+				},
 				"status": {
 					Type:        schema.TypeSet,
 					Optional:    true,
@@ -217,6 +222,7 @@ func dataSourceGroupConnectorsFlattenConnectors(resp *groups.GroupListConnectors
 		connector["failed_at"] = v.FailedAt.String()
 		connector["sync_frequency"] = v.SyncFrequency
 		connector["schedule_type"] = v.ScheduleType
+		connector["paused"] = v.Paused != nil && *v.Paused
 		connector["daily_sync_time"] = v.DailySyncTime
 
 		// Status
@@ -1,10 +1,83 @@
 package fivetran
 
-import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+import (
+	"context"
+	"fmt"
+
+	fivetran "github.com/fivetran/go-fivetran"
+	"github.com/fivetran/terraform-provider-fivetran/modules/helpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
 
 func dataSourceTeam() *schema.Resource {
 	return &schema.Resource{
-		ReadContext: resourceTeamRead,
-		Schema: 	 getTeamSchema(true),
+		ReadContext: dataSourceTeamRead,
+		Schema:      getTeamSchema(true),
+	}
+}
+
+func getTeamSchema(datasource bool) map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": {
+			Type:        schema.TypeString,
+			Required:    datasource,
+			Computed:    !datasource,
+			Description: "The unique identifier for the team within your account.",
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Required:    !datasource,
+			Computed:    datasource,
+			Description: "The name of the team within your account.",
+		},
+		"description": {
+			Type:        schema.TypeString,
+			Optional:    !datasource,
+			Computed:    datasource,
+			Description: "The description of the team within your account.",
+		},
+		"role": {
+			Type:        schema.TypeString,
+			Required:    !datasource,
+			Computed:    datasource,
+			Description: "The account role of the team.",
+		},
+	}
+}
+
+func dataSourceTeamRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	client := m.(*fivetran.Client)
+	svc := client.NewTeamsDetails()
+
+	svc.TeamId(d.Get("id").(string)).Do(ctx)
+
+	resp, err := svc.Do(ctx)
+	if err != nil {
+		// If the resource does not exist (404), inform Terraform. We want to immediately
+		// return here to prevent further processing.
+		if resp.Code == "404" {
+			d.SetId("")
+			return nil
+		}
+		return helpers.NewDiagAppend(diags, diag.Error, "read error", fmt.Sprintf("%v; code: %v", err, resp.Code))
+	}
+
+	// msi stands for Map String Interface
+	msi := make(map[string]interface{})
+	msi["id"] = resp.Data.Id
+	msi["name"] = resp.Data.Name
+	msi["description"] = resp.Data.Description
+	msi["role"] = resp.Data.Role
+
+	for k, v := range msi {
+		if err := d.Set(k, v); err != nil {
+			return helpers.NewDiagAppend(diags, diag.Error, "set error", fmt.Sprint(err))
+		}
 	}
-}
\ No newline at end of file
+
+	d.SetId(resp.Data.Id)
+
+	return diags
+}
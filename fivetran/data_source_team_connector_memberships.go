@@ -87,3 +87,50 @@ func dataSourceTeamConnectorMembershipsGet(client *fivetran.Client, ctx context.
 
 	return resp, nil
 }
+
+func resourceTeamConnectorMembershipBase(datasource bool) map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The unique identifier for resource.",
+		},
+		"team_id": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "The unique identifier for the team within your account.",
+		},
+		"connector": resourceTeamConnectorMembershipBaseConnectors(datasource),
+	}
+}
+
+func resourceTeamConnectorMembershipBaseConnectors(datasource bool) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Set: func(v interface{}) int {
+			return helpers.StringInt32Hash(v.(map[string]interface{})["connector_id"].(string) + v.(map[string]interface{})["role"].(string))
+		},
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"connector_id": {
+					Type:        schema.TypeString,
+					Required:    true,
+					ForceNew:    !datasource,
+					Description: "The connector unique identifier",
+				},
+				"role": {
+					Type:        schema.TypeString,
+					Required:    !datasource,
+					Computed:    datasource,
+					Description: "The team's role that links the team and the connector",
+				},
+				"created_at": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The date and time the membership was created",
+				},
+			},
+		},
+	}
+}
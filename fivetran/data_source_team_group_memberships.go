@@ -87,3 +87,50 @@ func dataSourceTeamGroupMembershipsGet(client *fivetran.Client, ctx context.Cont
 
 	return resp, nil
 }
+
+func resourceTeamGroupMembershipBase(datasource bool) map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The unique identifier for resource.",
+		},
+		"team_id": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "The unique identifier for the team within your account.",
+		},
+		"group": resourceTeamGroupMembershipBaseGroups(datasource),
+	}
+}
+
+func resourceTeamGroupMembershipBaseGroups(datasource bool) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Set: func(v interface{}) int {
+			return helpers.StringInt32Hash(v.(map[string]interface{})["group_id"].(string) + v.(map[string]interface{})["role"].(string))
+		},
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"group_id": {
+					Type:        schema.TypeString,
+					Required:    true,
+					ForceNew:    !datasource,
+					Description: "The group unique identifier",
+				},
+				"role": {
+					Type:        schema.TypeString,
+					Required:    !datasource,
+					Computed:    datasource,
+					Description: "The team's role that links the team and the group",
+				},
+				"created_at": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The date and time the membership was created",
+				},
+			},
+		},
+	}
+}
@@ -86,3 +86,45 @@ func dataSourceTeamUserMembershipsGet(client *fivetran.Client, ctx context.Conte
 
 	return resp, nil
 }
+
+func resourceTeamUserMembershipBase(datasource bool) map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The unique identifier for resource.",
+		},
+		"team_id": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "The unique identifier for the team within your account.",
+		},
+		"user": resourceTeamUserMembershipBaseUsers(datasource),
+	}
+}
+
+func resourceTeamUserMembershipBaseUsers(datasource bool) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Set: func(v interface{}) int {
+			return helpers.StringInt32Hash(v.(map[string]interface{})["user_id"].(string) + v.(map[string]interface{})["role"].(string))
+		},
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"user_id": {
+					Type:        schema.TypeString,
+					Required:    true,
+					ForceNew:    !datasource,
+					Description: "The user unique identifier",
+				},
+				"role": {
+					Type:        schema.TypeString,
+					Required:    !datasource,
+					Computed:    datasource,
+					Description: "The team's role that links the team and the user",
+				},
+			},
+		},
+	}
+}
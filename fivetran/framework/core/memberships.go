@@ -0,0 +1,207 @@
+package core
+
+import (
+	"context"
+
+	"github.com/fivetran/go-fivetran"
+	"github.com/fivetran/go-fivetran/common"
+)
+
+// Membership is a connector, group or user membership of a team or a user, MemberId is the id of the connector, group or user
+type Membership struct {
+	MemberId  string
+	Role      string
+	CreatedAt string
+}
+
+// MembershipsApi performs the requests to the memberships of a single member type of a team or a user (the owner)
+type MembershipsApi struct {
+	list    func(ctx context.Context, client *fivetran.Client, ownerId, cursor string) ([]Membership, string, string, error)
+	details func(ctx context.Context, client *fivetran.Client, ownerId, memberId string) (Membership, string, error)
+	create  func(ctx context.Context, client *fivetran.Client, ownerId, memberId, role string) (common.CommonResponse, error)
+	modify  func(ctx context.Context, client *fivetran.Client, ownerId, memberId, role string) (common.CommonResponse, error)
+	delete  func(ctx context.Context, client *fivetran.Client, ownerId, memberId string) (common.CommonResponse, error)
+}
+
+// List returns all the memberships of the owner and the response code of the last list request
+func (a MembershipsApi) List(ctx context.Context, client *fivetran.Client, ownerId string) ([]Membership, string, error) {
+	result := []Membership{}
+	var cursor string
+
+	for {
+		items, nextCursor, code, err := a.list(ctx, client, ownerId, cursor)
+		if err != nil {
+			return result, code, err
+		}
+
+		result = append(result, items...)
+
+		if nextCursor == "" {
+			return result, code, nil
+		}
+
+		cursor = nextCursor
+	}
+}
+
+// Details returns a single membership of the owner and the response code
+func (a MembershipsApi) Details(ctx context.Context, client *fivetran.Client, ownerId, memberId string) (Membership, string, error) {
+	return a.details(ctx, client, ownerId, memberId)
+}
+
+func (a MembershipsApi) Create(ctx context.Context, client *fivetran.Client, ownerId, memberId, role string) (common.CommonResponse, error) {
+	return a.create(ctx, client, ownerId, memberId, role)
+}
+
+func (a MembershipsApi) Modify(ctx context.Context, client *fivetran.Client, ownerId, memberId, role string) (common.CommonResponse, error) {
+	return a.modify(ctx, client, ownerId, memberId, role)
+}
+
+func (a MembershipsApi) Delete(ctx context.Context, client *fivetran.Client, ownerId, memberId string) (common.CommonResponse, error) {
+	return a.delete(ctx, client, ownerId, memberId)
+}
+
+var TeamConnectorMemberships = MembershipsApi{
+	list: func(ctx context.Context, client *fivetran.Client, ownerId, cursor string) ([]Membership, string, string, error) {
+		svc := client.NewTeamConnectorMembershipsList().TeamId(ownerId).Limit(1000)
+		if cursor != "" {
+			svc.Cursor(cursor)
+		}
+		resp, err := svc.Do(ctx)
+		items := []Membership{}
+		for _, v := range resp.Data.Items {
+			items = append(items, Membership{MemberId: v.ConnectorId, Role: v.Role, CreatedAt: v.CreatedAt})
+		}
+		return items, resp.Data.NextCursor, resp.Code, err
+	},
+	details: func(ctx context.Context, client *fivetran.Client, ownerId, memberId string) (Membership, string, error) {
+		resp, err := client.NewTeamConnectorMembershipDetails().TeamId(ownerId).ConnectorId(memberId).Do(ctx)
+		return Membership{MemberId: memberId, Role: resp.Data.Role, CreatedAt: resp.Data.CreatedAt}, resp.Code, err
+	},
+	create: func(ctx context.Context, client *fivetran.Client, ownerId, memberId, role string) (common.CommonResponse, error) {
+		resp, err := client.NewTeamConnectorMembershipCreate().TeamId(ownerId).ConnectorId(memberId).Role(role).Do(ctx)
+		return resp.CommonResponse, err
+	},
+	modify: func(ctx context.Context, client *fivetran.Client, ownerId, memberId, role string) (common.CommonResponse, error) {
+		return client.NewTeamConnectorMembershipModify().TeamId(ownerId).ConnectorId(memberId).Role(role).Do(ctx)
+	},
+	delete: func(ctx context.Context, client *fivetran.Client, ownerId, memberId string) (common.CommonResponse, error) {
+		return client.NewTeamConnectorMembershipDelete().TeamId(ownerId).ConnectorId(memberId).Do(ctx)
+	},
+}
+
+var TeamGroupMemberships = MembershipsApi{
+	list: func(ctx context.Context, client *fivetran.Client, ownerId, cursor string) ([]Membership, string, string, error) {
+		svc := client.NewTeamGroupMembershipsList().TeamId(ownerId).Limit(1000)
+		if cursor != "" {
+			svc.Cursor(cursor)
+		}
+		resp, err := svc.Do(ctx)
+		items := []Membership{}
+		for _, v := range resp.Data.Items {
+			items = append(items, Membership{MemberId: v.GroupId, Role: v.Role, CreatedAt: v.CreatedAt})
+		}
+		return items, resp.Data.NextCursor, resp.Code, err
+	},
+	details: func(ctx context.Context, client *fivetran.Client, ownerId, memberId string) (Membership, string, error) {
+		resp, err := client.NewTeamGroupMembershipDetails().TeamId(ownerId).GroupId(memberId).Do(ctx)
+		return Membership{MemberId: memberId, Role: resp.Data.Role, CreatedAt: resp.Data.CreatedAt}, resp.Code, err
+	},
+	create: func(ctx context.Context, client *fivetran.Client, ownerId, memberId, role string) (common.CommonResponse, error) {
+		resp, err := client.NewTeamGroupMembershipCreate().TeamId(ownerId).GroupId(memberId).Role(role).Do(ctx)
+		return resp.CommonResponse, err
+	},
+	modify: func(ctx context.Context, client *fivetran.Client, ownerId, memberId, role string) (common.CommonResponse, error) {
+		return client.NewTeamGroupMembershipModify().TeamId(ownerId).GroupId(memberId).Role(role).Do(ctx)
+	},
+	delete: func(ctx context.Context, client *fivetran.Client, ownerId, memberId string) (common.CommonResponse, error) {
+		return client.NewTeamGroupMembershipDelete().TeamId(ownerId).GroupId(memberId).Do(ctx)
+	},
+}
+
+var TeamUserMemberships = MembershipsApi{
+	list: func(ctx context.Context, client *fivetran.Client, ownerId, cursor string) ([]Membership, string, string, error) {
+		svc := client.NewTeamUserMembershipsList().TeamId(ownerId).Limit(1000)
+		if cursor != "" {
+			svc.Cursor(cursor)
+		}
+		resp, err := svc.Do(ctx)
+		items := []Membership{}
+		for _, v := range resp.Data.Items {
+			items = append(items, Membership{MemberId: v.UserId, Role: v.Role, CreatedAt: v.CreatedAt})
+		}
+		return items, resp.Data.NextCursor, resp.Code, err
+	},
+	details: func(ctx context.Context, client *fivetran.Client, ownerId, memberId string) (Membership, string, error) {
+		resp, err := client.NewTeamUserMembershipDetails().TeamId(ownerId).UserId(memberId).Do(ctx)
+		return Membership{MemberId: memberId, Role: resp.Data.Role, CreatedAt: resp.Data.CreatedAt}, resp.Code, err
+	},
+	create: func(ctx context.Context, client *fivetran.Client, ownerId, memberId, role string) (common.CommonResponse, error) {
+		resp, err := client.NewTeamUserMembershipCreate().TeamId(ownerId).UserId(memberId).Role(role).Do(ctx)
+		return resp.CommonResponse, err
+	},
+	modify: func(ctx context.Context, client *fivetran.Client, ownerId, memberId, role string) (common.CommonResponse, error) {
+		return client.NewTeamUserMembershipModify().TeamId(ownerId).UserId(memberId).Role(role).Do(ctx)
+	},
+	delete: func(ctx context.Context, client *fivetran.Client, ownerId, memberId string) (common.CommonResponse, error) {
+		return client.NewTeamUserMembershipDelete().TeamId(ownerId).UserId(memberId).Do(ctx)
+	},
+}
+
+var UserConnectorMemberships = MembershipsApi{
+	list: func(ctx context.Context, client *fivetran.Client, ownerId, cursor string) ([]Membership, string, string, error) {
+		svc := client.NewUserConnectorMembershipsList().UserId(ownerId).Limit(1000)
+		if cursor != "" {
+			svc.Cursor(cursor)
+		}
+		resp, err := svc.Do(ctx)
+		items := []Membership{}
+		for _, v := range resp.Data.Items {
+			items = append(items, Membership{MemberId: v.ConnectorId, Role: v.Role, CreatedAt: v.CreatedAt})
+		}
+		return items, resp.Data.NextCursor, resp.Code, err
+	},
+	details: func(ctx context.Context, client *fivetran.Client, ownerId, memberId string) (Membership, string, error) {
+		resp, err := client.NewUserConnectorMembershipDetails().UserId(ownerId).ConnectorId(memberId).Do(ctx)
+		return Membership{MemberId: memberId, Role: resp.Data.Role, CreatedAt: resp.Data.CreatedAt}, resp.Code, err
+	},
+	create: func(ctx context.Context, client *fivetran.Client, ownerId, memberId, role string) (common.CommonResponse, error) {
+		resp, err := client.NewUserConnectorMembershipCreate().UserId(ownerId).ConnectorId(memberId).Role(role).Do(ctx)
+		return resp.CommonResponse, err
+	},
+	modify: func(ctx context.Context, client *fivetran.Client, ownerId, memberId, role string) (common.CommonResponse, error) {
+		return client.NewUserConnectorMembershipModify().UserId(ownerId).ConnectorId(memberId).Role(role).Do(ctx)
+	},
+	delete: func(ctx context.Context, client *fivetran.Client, ownerId, memberId string) (common.CommonResponse, error) {
+		return client.NewUserConnectorMembershipDelete().UserId(ownerId).ConnectorId(memberId).Do(ctx)
+	},
+}
+
+var UserGroupMemberships = MembershipsApi{
+	list: func(ctx context.Context, client *fivetran.Client, ownerId, cursor string) ([]Membership, string, string, error) {
+		svc := client.NewUserGroupMembershipsList().UserId(ownerId).Limit(1000)
+		if cursor != "" {
+			svc.Cursor(cursor)
+		}
+		resp, err := svc.Do(ctx)
+		items := []Membership{}
+		for _, v := range resp.Data.Items {
+			items = append(items, Membership{MemberId: v.GroupId, Role: v.Role, CreatedAt: v.CreatedAt})
+		}
+		return items, resp.Data.NextCursor, resp.Code, err
+	},
+	details: func(ctx context.Context, client *fivetran.Client, ownerId, memberId string) (Membership, string, error) {
+		resp, err := client.NewUserGroupMembershipDetails().UserId(ownerId).GroupId(memberId).Do(ctx)
+		return Membership{MemberId: memberId, Role: resp.Data.Role, CreatedAt: resp.Data.CreatedAt}, resp.Code, err
+	},
+	create: func(ctx context.Context, client *fivetran.Client, ownerId, memberId, role string) (common.CommonResponse, error) {
+		resp, err := client.NewUserGroupMembershipCreate().UserId(ownerId).GroupId(memberId).Role(role).Do(ctx)
+		return resp.CommonResponse, err
+	},
+	modify: func(ctx context.Context, client *fivetran.Client, ownerId, memberId, role string) (common.CommonResponse, error) {
+		return client.NewUserGroupMembershipModify().UserId(ownerId).GroupId(memberId).Role(role).Do(ctx)
+	},
+	delete: func(ctx context.Context, client *fivetran.Client, ownerId, memberId string) (common.CommonResponse, error) {
+		return client.NewUserGroupMembershipDelete().UserId(ownerId).GroupId(memberId).Do(ctx)
+	},
+}
@@ -0,0 +1,246 @@
+package model
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/common"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// validateConfigObject checks that only fields available for the service are set in the object value,
+// nested values have expected shape and all the fields required for the service are set
+func validateConfigObject(value attr.Value, p path.Path, service string, fields map[string]common.ConfigField) diag.Diagnostics {
+	return validateConfigFields(value, p, service, fields, nil)
+}
+
+// validateConfigFields does the same as validateConfigObject, required fields reported as `provided` are not checked
+func validateConfigFields(value attr.Value, p path.Path, service string, fields map[string]common.ConfigField, provided func(string) bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	object, ok := value.(basetypes.ObjectValue)
+	if !ok || object.IsNull() || object.IsUnknown() {
+		return diags
+	}
+
+	attrs := object.Attributes()
+
+	for _, fn := range sortedNames(attrs) {
+		av := attrs[fn]
+		if isNotSet(av) {
+			continue
+		}
+		field, ok := fields[fn]
+		if !ok {
+			serviceSpecificName := fn + "_" + service
+			if _, ok := fields[serviceSpecificName]; ok {
+				diags.AddAttributeError(p.AtName(fn),
+					"Unsupported config field.",
+					fmt.Sprintf("Field `%v` isn't expected for service `%v`, try use `%v` instead.", fn, service, serviceSpecificName))
+			} else {
+				diags.AddAttributeError(p.AtName(fn),
+					"Unsupported config field.",
+					fmt.Sprintf("Field `%v` isn't supported for service `%v`.", fn, service))
+			}
+			continue
+		}
+		diags.Append(validateConfigValue(av, p.AtName(fn), service, field)...)
+	}
+
+	for _, fn := range sortedNames(fields) {
+		if !fields[fn].Required[service] || (provided != nil && provided(fn)) {
+			continue
+		}
+		if av, ok := attrs[fn]; !ok || isNotSet(av) {
+			diags.AddAttributeError(p.AtName(fn),
+				"Missing required config field.",
+				fmt.Sprintf("Field `%v` is required for service `%v`.", fn, service))
+		}
+	}
+
+	return diags
+}
+
+// validateConfigWithJson validates the typed block together with the corresponding `*_json` attribute:
+// the JSON should be an object, its keys can't duplicate fields set in the block and required fields can be set in either of them
+func validateConfigWithJson(value attr.Value, p path.Path, jsonValue types.String, jsonPath path.Path, service string, fields map[string]common.ConfigField) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if jsonValue.IsNull() {
+		return validateConfigObject(value, p, service, fields)
+	}
+	if jsonValue.IsUnknown() {
+		// any of the required fields may be set in JSON that is not known yet
+		return validateConfigFields(value, p, service, fields, func(string) bool { return true })
+	}
+
+	jsonFields, err := decodeConfigJson(jsonValue)
+	if err != nil {
+		diags.AddAttributeError(jsonPath,
+			"Invalid config JSON.",
+			fmt.Sprintf("The value should be a JSON object: %v.", err))
+		return diags
+	}
+	provided := func(fn string) bool {
+		return isJsonConfigField(jsonFields, fn, fields)
+	}
+
+	if object, ok := value.(basetypes.ObjectValue); ok && !object.IsNull() && !object.IsUnknown() {
+		attrs := object.Attributes()
+		for _, fn := range sortedNames(attrs) {
+			if !isNotSet(attrs[fn]) && provided(fn) {
+				diags.AddAttributeError(jsonPath,
+					"Conflicting config field.",
+					fmt.Sprintf("Field `%v` can't be set in both `%v` and `%v`.", fn, p, jsonPath))
+			}
+		}
+	}
+
+	diags.Append(validateConfigFields(value, p, service, fields, provided)...)
+	return diags
+}
+
+// isJsonConfigField checks if the field is set in JSON using either the field name or the REST API field name
+func isJsonConfigField(jsonFields map[string]interface{}, fn string, fields map[string]common.ConfigField) bool {
+	if _, ok := jsonFields[fn]; ok {
+		return true
+	}
+	if f, ok := fields[fn]; ok && f.ApiField != "" {
+		_, ok := jsonFields[f.ApiField]
+		return ok
+	}
+	return false
+}
+
+func validateConfigValue(value attr.Value, p path.Path, service string, field common.ConfigField) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if value.IsUnknown() {
+		return diags
+	}
+
+	switch field.FieldValueType {
+	case common.Integer:
+		diags.Append(validateConfigRange(value, p, service, field)...)
+	case common.String:
+		diags.Append(validateConfigItemType(value, p, service, field)...)
+		diags.Append(validateConfigEnum(value, p, service, field)...)
+	case common.StringList:
+		if set, ok := value.(basetypes.SetValue); ok {
+			for _, element := range set.Elements() {
+				diags.Append(validateConfigItemType(element, p.AtSetValue(element), service, field)...)
+				diags.Append(validateConfigEnum(element, p.AtSetValue(element), service, field)...)
+			}
+		}
+	case common.Object:
+		diags.Append(validateConfigObject(value, p, service, itemFieldsForService(field, service))...)
+	case common.ObjectList:
+		if set, ok := value.(basetypes.SetValue); ok {
+			for _, element := range set.Elements() {
+				diags.Append(validateConfigObject(element, p.AtSetValue(element), service, itemFieldsForService(field, service))...)
+			}
+		}
+	}
+
+	return diags
+}
+
+// validateConfigItemType checks string values that are sent to the API as integers for the service
+func validateConfigItemType(value attr.Value, p path.Path, service string, field common.ConfigField) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if field.ItemType[service] != common.Integer {
+		return diags
+	}
+	if s, ok := value.(basetypes.StringValue); ok && !s.IsNull() && !s.IsUnknown() {
+		if _, err := strconv.Atoi(s.ValueString()); err != nil {
+			diags.AddAttributeError(p,
+				"Invalid config field value.",
+				fmt.Sprintf("Value `%v` should be an integer for service `%v`.", s.ValueString(), service))
+		}
+	}
+	return diags
+}
+
+// validateConfigEnum warns if string value is not one of the possible values of the field for the service:
+// possible values from the OpenAPI spec may lag behind the REST API, so the value is still sent
+func validateConfigEnum(value attr.Value, p path.Path, service string, field common.ConfigField) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	options := field.Enum[service]
+	if len(options) == 0 || field.ItemType[service] == common.Integer {
+		return diags
+	}
+	if s, ok := value.(basetypes.StringValue); ok && !s.IsNull() && !s.IsUnknown() {
+		for _, o := range options {
+			if o == s.ValueString() {
+				return diags
+			}
+		}
+		diags.AddAttributeWarning(p,
+			"Unsupported config field value.",
+			fmt.Sprintf("Value `%v` isn't supported for service `%v`, possible values: `%v`.", s.ValueString(), service, strings.Join(options, "`, `")))
+	}
+	return diags
+}
+
+// validateConfigRange checks that integer value is within the minimum and maximum of the field for the service
+func validateConfigRange(value attr.Value, p path.Path, service string, field common.ConfigField) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	i, ok := value.(basetypes.Int64Value)
+	if !ok || i.IsNull() || i.IsUnknown() {
+		return diags
+	}
+	if minimum, ok := field.Minimum[service]; ok && i.ValueInt64() < minimum {
+		diags.AddAttributeError(p,
+			"Invalid config field value.",
+			fmt.Sprintf("Value `%v` should be at least `%v` for service `%v`.", i.ValueInt64(), minimum, service))
+	}
+	if maximum, ok := field.Maximum[service]; ok && i.ValueInt64() > maximum {
+		diags.AddAttributeError(p,
+			"Invalid config field value.",
+			fmt.Sprintf("Value `%v` should be at most `%v` for service `%v`.", i.ValueInt64(), maximum, service))
+	}
+	return diags
+}
+
+// itemFieldsForService returns sub-fields available for the service, sub-fields without descriptions are available for any service
+func itemFieldsForService(field common.ConfigField, service string) map[string]common.ConfigField {
+	result := make(map[string]common.ConfigField)
+	for fn, f := range field.ItemFields {
+		if _, ok := f.Description[service]; ok || len(f.Description) == 0 {
+			result[fn] = f
+		}
+	}
+	return result
+}
+
+// isNotSet returns true for null values and empty collections (omitted nested blocks)
+func isNotSet(value attr.Value) bool {
+	if value.IsNull() {
+		return true
+	}
+	if value.IsUnknown() {
+		return false
+	}
+	if set, ok := value.(basetypes.SetValue); ok {
+		return len(set.Elements()) == 0
+	}
+	return false
+}
+
+func sortedNames[T any](m map[string]T) []string {
+	result := make([]string, 0, len(m))
+	for k := range m {
+		result = append(result, k)
+	}
+	sort.Strings(result)
+	return result
+}
@@ -0,0 +1,70 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/common"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func requiredConfigForTest(host types.String) (types.Object, map[string]common.ConfigField) {
+	fields := map[string]common.ConfigField{
+		"host": {FieldValueType: common.String, Required: map[string]bool{"custom_service": true}},
+		"port": {FieldValueType: common.Integer},
+	}
+	value := types.ObjectValueMust(
+		map[string]attr.Type{"host": types.StringType, "port": types.Int64Type},
+		map[string]attr.Value{"host": host, "port": types.Int64Value(123)})
+	return value, fields
+}
+
+func TestValidateConfigRequiredField(t *testing.T) {
+	p := path.Root("config")
+	jsonPath := path.Root("config_json")
+
+	value, fields := requiredConfigForTest(types.StringValue("host"))
+	if diags := validateConfigObject(value, p, "custom_service", fields); diags.HasError() {
+		t.Errorf("set required field should pass, got %v", diags)
+	}
+	if diags := validateConfigObject(value, p, "other_service", fields); diags.HasError() {
+		t.Errorf("field is required only for the service, got %v", diags)
+	}
+
+	value, fields = requiredConfigForTest(types.StringNull())
+	if diags := validateConfigObject(value, p, "custom_service", fields); diags.ErrorsCount() != 1 {
+		t.Errorf("missing required field should be reported, got %v", diags)
+	}
+	if diags := validateConfigWithJson(value, p, types.StringValue(`{"host": "host"}`), jsonPath, "custom_service", fields); diags.HasError() {
+		t.Errorf("required field set in JSON should pass, got %v", diags)
+	}
+	if diags := validateConfigWithJson(value, p, types.StringUnknown(), jsonPath, "custom_service", fields); diags.HasError() {
+		t.Errorf("required field may be set in unknown JSON, got %v", diags)
+	}
+	if diags := validateConfigWithJson(value, p, types.StringValue(`{"port": 123}`), jsonPath, "custom_service", fields); diags.ErrorsCount() != 2 {
+		t.Errorf("missing required field and conflicting field should be reported, got %v", diags)
+	}
+}
+
+func TestValidateConfigRange(t *testing.T) {
+	field := common.ConfigField{
+		FieldValueType: common.Integer,
+		Minimum:        map[string]int64{"custom_service": 1},
+		Maximum:        map[string]int64{"custom_service": 65535},
+	}
+	p := path.Root("config").AtName("port")
+
+	if diags := validateConfigValue(types.Int64Value(123), p, "custom_service", field); diags.HasError() {
+		t.Errorf("value within the range should pass, got %v", diags)
+	}
+	if diags := validateConfigValue(types.Int64Value(0), p, "custom_service", field); diags.ErrorsCount() != 1 {
+		t.Errorf("value below the minimum should be reported, got %v", diags)
+	}
+	if diags := validateConfigValue(types.Int64Value(70000), p, "custom_service", field); diags.ErrorsCount() != 1 {
+		t.Errorf("value above the maximum should be reported, got %v", diags)
+	}
+	if diags := validateConfigValue(types.Int64Value(0), p, "other_service", field); diags.HasError() {
+		t.Errorf("range is set only for the service, got %v", diags)
+	}
+}
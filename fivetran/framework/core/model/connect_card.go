@@ -0,0 +1,62 @@
+package model
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"time"
+
+	connectcard "github.com/fivetran/go-fivetran/connect_card"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type ConnectCard struct {
+	Id             types.String `tfsdk:"id"`
+	ConnectorId    types.String `tfsdk:"connector_id"`
+	RedirectUri    types.String `tfsdk:"redirect_uri"`
+	HideSetupGuide types.Bool   `tfsdk:"hide_setup_guide"`
+	Uri            types.String `tfsdk:"uri"`
+	Token          types.String `tfsdk:"token"`
+	TokenExpiresAt types.String `tfsdk:"token_expires_at"`
+}
+
+func (d *ConnectCard) ReadFromResponse(resp connectcard.ConnectCardResponse) {
+	d.Id = types.StringValue(resp.Data.ConnectorId)
+	d.ConnectorId = types.StringValue(resp.Data.ConnectorId)
+	d.Uri = types.StringValue(resp.Data.ConnectCard.Uri)
+	d.Token = types.StringValue(resp.Data.ConnectCard.Token)
+
+	if expiresAt, ok := getTokenExpiration(resp.Data.ConnectCard.Token); ok {
+		d.TokenExpiresAt = types.StringValue(expiresAt.UTC().Format(time.RFC3339))
+	} else {
+		d.TokenExpiresAt = types.StringNull()
+	}
+}
+
+// IsExpired reports whether the token expiration time is known and has passed
+func (d *ConnectCard) IsExpired() bool {
+	if d.TokenExpiresAt.IsNull() || d.TokenExpiresAt.IsUnknown() {
+		return false
+	}
+	expiresAt, err := time.Parse(time.RFC3339, d.TokenExpiresAt.ValueString())
+	return err == nil && time.Now().After(expiresAt)
+}
+
+// getTokenExpiration reads the `exp` claim of the Connect Card token, the token is a JWT
+func getTokenExpiration(token string) (time.Time, bool) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return time.Time{}, false
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return time.Time{}, false
+	}
+	var claims struct {
+		Exp *int64 `json:"exp"`
+	}
+	if err := json.Unmarshal(payload, &claims); err != nil || claims.Exp == nil {
+		return time.Time{}, false
+	}
+	return time.Unix(*claims.Exp, 0), true
+}
@@ -1,14 +1,19 @@
 package model
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
+	"time"
 
 	gfcommon "github.com/fivetran/go-fivetran/common"
 	"github.com/fivetran/go-fivetran/connectors"
 	"github.com/fivetran/terraform-provider-fivetran/fivetran/common"
+	"github.com/fivetran/terraform-provider-fivetran/modules/fivetranapi"
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 )
@@ -117,13 +122,25 @@ type ConnectorResourceModel struct {
 	Service           types.String `tfsdk:"service"`
 	DestinationSchema types.Object `tfsdk:"destination_schema"`
 
-	Config   types.Object   `tfsdk:"config"`
-	Auth     types.Object   `tfsdk:"auth"`
-	Timeouts timeouts.Value `tfsdk:"timeouts"`
+	Config     types.Object   `tfsdk:"config"`
+	Auth       types.Object   `tfsdk:"auth"`
+	ConfigJson types.String   `tfsdk:"config_json"`
+	AuthJson   types.String   `tfsdk:"auth_json"`
+	Timeouts   timeouts.Value `tfsdk:"timeouts"`
 
-	RunSetupTests     types.Bool `tfsdk:"run_setup_tests"`
-	TrustCertificates types.Bool `tfsdk:"trust_certificates"`
-	TrustFingerprints types.Bool `tfsdk:"trust_fingerprints"`
+	CredentialsVersion types.Int64 `tfsdk:"credentials_version"`
+
+	RunSetupTests          types.Bool `tfsdk:"run_setup_tests"`
+	TrustCertificates      types.Bool `tfsdk:"trust_certificates"`
+	TrustFingerprints      types.Bool `tfsdk:"trust_fingerprints"`
+	WaitForSetup           types.Bool `tfsdk:"wait_for_setup"`
+	FailOnSetupTestFailure types.Bool `tfsdk:"fail_on_setup_test_failure"`
+
+	NetworkingMethod types.String `tfsdk:"networking_method"`
+	PrivateLinkId    types.String `tfsdk:"private_link_id"`
+	ProxyAgentId     types.String `tfsdk:"proxy_agent_id"`
+
+	SetupTests types.List `tfsdk:"setup_tests"`
 }
 
 func (d *ConnectorResourceModel) ReadFromResponse(resp connectors.DetailsWithCustomConfigNoTestsResponse) {
@@ -136,22 +153,32 @@ func (d *ConnectorResourceModel) ReadFromCreateResponse(resp connectors.DetailsW
 	responseContainer := ConnectorModelContainer{}
 	responseContainer.ReadFromResponseData(resp.Data.DetailsResponseDataCommon, resp.Data.Config)
 	d.ReadFromContainer(responseContainer)
+	d.SetupTests = readSetupTests(resp.Data.SetupTests)
 }
 
+// GetFailedSetupTests returns descriptions of the failed setup tests
+func (d *ConnectorResourceModel) GetFailedSetupTests() []string {
+	return getFailedSetupTests(d.SetupTests)
+}
+
+// GetConfigMap returns `config` fields merged with fields from `config_json`
 func (d *ConnectorResourceModel) GetConfigMap(nullOnNull bool) (map[string]interface{}, error) {
-	if d.Config.IsNull() && nullOnNull {
+	if d.Config.IsNull() && d.ConfigJson.IsNull() && nullOnNull {
 		return nil, nil
 	}
 	result := getValueFromAttrValue(d.Config, common.GetConfigFieldsMap(), nil, d.Service.ValueString()).(map[string]interface{})
 	serviceName := d.Service.ValueString()
 	serviceFields := common.GetFieldsForService(serviceName)
 	allFields := common.GetConfigFieldsMap()
-	err := patchServiceSpecificFields(result, serviceName, serviceFields, allFields)
-	return result, err
+	if err := patchServiceSpecificFields(result, serviceName, serviceFields, allFields); err != nil {
+		return result, err
+	}
+	return mergeConfigJson(result, d.ConfigJson, "config_json")
 }
 
+// GetAuthMap returns `auth` fields merged with fields from `auth_json`
 func (d *ConnectorResourceModel) GetAuthMap(nullOnNull bool) (map[string]interface{}, error) {
-	if d.Auth.IsNull() && nullOnNull {
+	if d.Auth.IsNull() && d.AuthJson.IsNull() && nullOnNull {
 		return nil, nil
 	}
 	serviceName := d.Service.ValueString()
@@ -159,8 +186,28 @@ func (d *ConnectorResourceModel) GetAuthMap(nullOnNull bool) (map[string]interfa
 	allFields := common.GetAuthFieldsMap()
 
 	result := getValueFromAttrValue(d.Auth, allFields, nil, serviceName).(map[string]interface{})
-	err := patchServiceSpecificFields(result, serviceName, serviceFields, allFields)
-	return result, err
+	if err := patchServiceSpecificFields(result, serviceName, serviceFields, allFields); err != nil {
+		return result, err
+	}
+	return mergeConfigJson(result, d.AuthJson, "auth_json")
+}
+
+// ValidateConfig checks config and auth fields against the fields available for the connector service
+func (d *ConnectorResourceModel) ValidateConfig() diag.Diagnostics {
+	var diags diag.Diagnostics
+	if d.Service.IsNull() || d.Service.IsUnknown() {
+		return diags
+	}
+	serviceName := d.Service.ValueString()
+	if _, ok := common.GetDestinationSchemaFields()[serviceName]; !ok {
+		diags.AddAttributeError(path.Root("service"),
+			"Unknown connector service.",
+			fmt.Sprintf("Service `%v` isn't supported by the provider.", serviceName))
+		return diags
+	}
+	diags.Append(validateConfigWithJson(d.Config, path.Root("config"), d.ConfigJson, path.Root("config_json"), serviceName, common.GetFieldsForService(serviceName))...)
+	diags.Append(validateConfigWithJson(d.Auth, path.Root("auth"), d.AuthJson, path.Root("auth_json"), serviceName, common.GetAuthFieldsForService(serviceName))...)
+	return diags
 }
 
 func (d *ConnectorResourceModel) GetDestinatonSchemaForConfig() (map[string]interface{}, error) {
@@ -308,3 +355,114 @@ func getDestinationSchemaValuesMap(service, schema string) map[string]attr.Value
 		return result
 	}
 }
+
+// CredentialsVersionChanged reports whether `credentials_version` is changed, so all the sensitive fields should be sent again
+func (d *ConnectorResourceModel) CredentialsVersionChanged(state ConnectorResourceModel) bool {
+	return !d.CredentialsVersion.IsNull() && !d.CredentialsVersion.Equal(state.CredentialsVersion)
+}
+
+// AuthTimestampsPrivateKey is the private state key of token expiration timestamps read from the connector config:
+// the fields are not configured in Terraform, so their values are not kept in the state
+const AuthTimestampsPrivateKey = "auth_timestamps"
+
+// tokenRefreshWindow is the time before token expiration when Fivetran refreshes the token: a later expiration timestamp
+// set within this window is treated as a routine refresh, a timestamp changed earlier means the connector was re-authenticated
+const tokenRefreshWindow = 30 * 24 * time.Hour
+
+// authTimestampFields are config fields that contain expiration timestamps of the connector credentials
+var authTimestampFields = []string{"refresh_token_expires_at"}
+
+// ReadAuthTimestamps returns token expiration timestamps from the connector config in the private state format
+func ReadAuthTimestamps(config map[string]interface{}) []byte {
+	timestamps := make(map[string]string)
+	for _, fn := range authTimestampFields {
+		if v, ok := config[fn].(string); ok && v != "" {
+			timestamps[fn] = v
+		}
+	}
+	result, _ := json.Marshal(timestamps)
+	return result
+}
+
+// CheckCredentialsChanged warns when the connector could be re-authenticated outside of Terraform since the previous read.
+// The API doesn't expose the time of authentication, so re-authentication is detected by the user who connected the connector
+// and by changes of token expiration timestamps that don't look like routine token refreshes.
+func (d *ConnectorResourceModel) CheckCredentialsChanged(previousConnectedBy types.String, previousTimestamps, timestamps []byte) diag.Diagnostics {
+	return d.checkCredentialsChanged(previousConnectedBy, previousTimestamps, timestamps, time.Now())
+}
+
+func (d *ConnectorResourceModel) checkCredentialsChanged(previousConnectedBy types.String, previousTimestamps, timestamps []byte, now time.Time) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if previousConnectedBy.IsNull() || previousConnectedBy.IsUnknown() || previousConnectedBy.ValueString() == "" {
+		return diags
+	}
+	if current := d.ConnectedBy.ValueString(); current != "" && current != previousConnectedBy.ValueString() {
+		diags.AddWarning("Connector Credentials Changed Outside of Terraform.",
+			fmt.Sprintf("The value of `connected_by` of connector `%v` changed from `%v` to `%v`: the connector could be re-authenticated with different credentials. "+
+				"Change `credentials_version` to send the configured sensitive values again.",
+				d.Id.ValueString(), previousConnectedBy.ValueString(), current))
+		return diags
+	}
+
+	// timestamps are not known before the first read
+	if len(previousTimestamps) == 0 {
+		return diags
+	}
+	before, after := map[string]string{}, map[string]string{}
+	if json.Unmarshal(previousTimestamps, &before) != nil || json.Unmarshal(timestamps, &after) != nil {
+		return diags
+	}
+	for _, fn := range authTimestampFields {
+		if before[fn] == after[fn] || isRoutineTokenRefresh(before[fn], after[fn], now) {
+			continue
+		}
+		diags.AddWarning("Connector Credentials Changed Outside of Terraform.",
+			fmt.Sprintf("The value of `%v` of connector `%v` changed from `%v` to `%v`: the connector could be re-authenticated with different credentials. "+
+				"Change `credentials_version` to send the configured sensitive values again.",
+				fn, d.Id.ValueString(), before[fn], after[fn]))
+	}
+	return diags
+}
+
+// isRoutineTokenRefresh reports whether the token expiration timestamp is moved forward when the previous token expires soon
+func isRoutineTokenRefresh(before, after string, now time.Time) bool {
+	beforeTime, err := time.Parse(time.RFC3339, before)
+	if err != nil {
+		return false
+	}
+	afterTime, err := time.Parse(time.RFC3339, after)
+	if err != nil {
+		return false
+	}
+	return afterTime.After(beforeTime) && beforeTime.Sub(now) < tokenRefreshWindow
+}
+
+// HasNetworking reports whether `networking_method`, `private_link_id` or `proxy_agent_id` is set in the configuration
+func (d *ConnectorResourceModel) HasNetworking() bool {
+	return d.networking().isSet()
+}
+
+// NetworkingChanged reports whether configured networking fields differ from the state
+func (d *ConnectorResourceModel) NetworkingChanged(state ConnectorResourceModel) bool {
+	return d.networking().changed(state.networking())
+}
+
+func (d *ConnectorResourceModel) GetNetworkingModifyRequest() fivetranapi.NetworkingModifyRequest {
+	return d.networking().getModifyRequest()
+}
+
+// ReadNetworking refreshes networking fields managed in the configuration
+func (d *ConnectorResourceModel) ReadNetworking(resp fivetranapi.NetworkingResponse) {
+	d.NetworkingMethod = readNetworkingValue(d.NetworkingMethod, resp.Data.NetworkingMethod)
+	d.PrivateLinkId = readNetworkingValue(d.PrivateLinkId, resp.Data.PrivateLinkId)
+	d.ProxyAgentId = readNetworkingValue(d.ProxyAgentId, resp.Data.ProxyAgentId)
+}
+
+func (d *ConnectorResourceModel) networking() networkingFields {
+	return networkingFields{
+		NetworkingMethod:        d.NetworkingMethod,
+		PrivateLinkId:           d.PrivateLinkId,
+		ProxyAgentId:            d.ProxyAgentId,
+		HybridDeploymentAgentId: types.StringNull(),
+	}
+}
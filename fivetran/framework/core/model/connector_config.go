@@ -1,6 +1,7 @@
 package model
 
 import (
+	"encoding/json"
 	"fmt"
 	"reflect"
 	"strconv"
@@ -25,7 +26,7 @@ func PrepareConfigAuthPatch(state, plan map[string]interface{}, service string,
 	// Filter out non nullable fields
 	for k := range state {
 		if _, ok := plan[k]; !ok {
-			if f, ok := allFields[k]; ok {
+			if f, ok := serviceField(allFields, k, service); ok {
 				if f.Nullable || f.FieldValueType == common.ObjectList || f.FieldValueType == common.StringList {
 					// If the field is not represented in plan (deleted from config)
 					// And the field is nullable - it should be set to null explicitly
@@ -37,7 +38,11 @@ func PrepareConfigAuthPatch(state, plan map[string]interface{}, service string,
 
 	for k, pv := range plan {
 		if sv, ok := state[k]; ok {
-			if reflect.DeepEqual(pv, sv) {
+			var field *common.ConfigField
+			if f, ok := serviceField(allFields, k, service); ok {
+				field = &f
+			}
+			if configValuesEqual(pv, sv, field, service) {
 				delete(result, k)
 			}
 		}
@@ -46,6 +51,150 @@ func PrepareConfigAuthPatch(state, plan map[string]interface{}, service string,
 	return result
 }
 
+// AddSensitiveFields adds fields that contain sensitive values from the plan to the patch
+func AddSensitiveFields(patch, plan map[string]interface{}, service string, allFields map[string]common.ConfigField) {
+	for k, v := range plan {
+		if field, ok := serviceField(allFields, k, service); ok && hasSensitiveValues(field) {
+			patch[k] = v
+		}
+	}
+}
+
+// serviceField returns the service specific definition of the field if it exists, the common one otherwise
+func serviceField(fields map[string]common.ConfigField, fieldName, service string) (common.ConfigField, bool) {
+	if field, ok := fields[fieldName+"_"+service]; ok {
+		return field, true
+	}
+	field, ok := fields[fieldName]
+	return field, ok
+}
+
+func hasSensitiveValues(field common.ConfigField) bool {
+	if field.Sensitive {
+		return true
+	}
+	for _, f := range field.ItemFields {
+		if hasSensitiveValues(f) {
+			return true
+		}
+	}
+	return false
+}
+
+// configValuesEqual compares config values, object lists with a key field are compared as keyed sets:
+// items are matched by key, so reordering of items is not a change.
+// Changed lists are still sent as a whole - the API replaces the list value.
+func configValuesEqual(a, b interface{}, field *common.ConfigField, service string) bool {
+	if field != nil && field.FieldValueType == common.ObjectList && field.ItemKeyField != "" {
+		aItems, aOk := keyedItems(a, field.ItemKeyField)
+		bItems, bOk := keyedItems(b, field.ItemKeyField)
+		if aOk && bOk {
+			if len(aItems) != len(bItems) {
+				return false
+			}
+			for key, aItem := range aItems {
+				bItem, ok := bItems[key]
+				if !ok || !configObjectsEqual(aItem, bItem, field.ItemFields, service) {
+					return false
+				}
+			}
+			return true
+		}
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+func configObjectsEqual(a, b map[string]interface{}, fields map[string]common.ConfigField, service string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for fn, av := range a {
+		bv, ok := b[fn]
+		if !ok {
+			return false
+		}
+		var field *common.ConfigField
+		if f, ok := serviceField(fields, fn, service); ok {
+			field = &f
+		}
+		if !configValuesEqual(av, bv, field, service) {
+			return false
+		}
+	}
+	return true
+}
+
+// keyedItems maps object list items by key, returns false if any item has no key or keys are not unique
+func keyedItems(value interface{}, keyField string) (map[string]map[string]interface{}, bool) {
+	list, ok := value.([]interface{})
+	if !ok {
+		return nil, false
+	}
+	result := make(map[string]map[string]interface{}, len(list))
+	for _, item := range list {
+		itemMap, ok := item.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		key, ok := itemKey(itemMap, keyField)
+		if !ok {
+			return nil, false
+		}
+		if _, ok := result[key]; ok {
+			return nil, false
+		}
+		result[key] = itemMap
+	}
+	return result, true
+}
+
+// itemKey returns the key of object list item, key field may list alternative fields separated with `|`
+func itemKey(item map[string]interface{}, keyField string) (string, bool) {
+	for _, kf := range strings.Split(keyField, "|") {
+		if v, ok := item[kf]; ok && v != nil {
+			return fmt.Sprintf("%v=%v", kf, v), true
+		}
+	}
+	return "", false
+}
+
+// sameItemKey checks that the upstream item has the same key as the local one
+func sameItemKey(local, upstream map[string]interface{}, keyField string) bool {
+	for _, kf := range strings.Split(keyField, "|") {
+		if lv, ok := local[kf]; ok && lv != nil {
+			uv, ok := upstream[kf]
+			return ok && reflect.DeepEqual(lv, uv)
+		}
+	}
+	return false
+}
+
+// decodeConfigJson decodes the value of `config_json` or `auth_json` attribute
+func decodeConfigJson(value types.String) (map[string]interface{}, error) {
+	result := map[string]interface{}{}
+	if value.IsNull() || value.IsUnknown() {
+		return result, nil
+	}
+	if err := json.Unmarshal([]byte(value.ValueString()), &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// mergeConfigJson adds fields decoded from `config_json` or `auth_json` to the request map.
+// Fields set in JSON override the values of the typed block: the block may contain values read from the upstream for such fields,
+// but setting the same field in both places is rejected on validation.
+func mergeConfigJson(result map[string]interface{}, value types.String, attribute string) (map[string]interface{}, error) {
+	jsonFields, err := decodeConfigJson(value)
+	if err != nil {
+		return nil, fmt.Errorf("`%v` should contain a JSON object: %v", attribute, err)
+	}
+	for k, v := range jsonFields {
+		result[k] = v
+	}
+	return result, nil
+}
+
 func getAttrTypes(configFieldsMap map[string]common.ConfigField) map[string]attr.Type {
 	result := make(map[string]attr.Type)
 	for fn, f := range configFieldsMap {
@@ -253,43 +402,21 @@ func getValue(
 			}
 		}
 		items := []attr.Value{}
-		for _, v := range value.([]interface{}) {
-			if currentField.ItemKeyField != "" && local != nil {
-				keyField := currentField.ItemKeyField
-				keyFields := strings.Split(keyField, "|")
-
-				vMap := v.(map[string]interface{})
-
-				if len(keyFields) > 1 {
-					// choose key field
-					fieldsMatch := 0
-					for _, kf := range keyFields {
-						if _, ok := vMap[kf]; ok { // if the field name represented in config - it could be key field
-							keyField = kf
-							fieldsMatch += 1
-						}
-					}
-					// if more than one keyField found in config we don't know how to associate local and upstream values
-					if fieldsMatch == 0 {
-						panic("No key fields defined in configuration, can't associate upstream items")
+		if currentField.ItemKeyField != "" && local != nil {
+			// upstream items are associated with local ones by key, the key field is chosen by local item.
+			// Items are read in the local order: reordering of keyed items is not a change
+			for _, li := range local.([]interface{}) {
+				for _, v := range value.([]interface{}) {
+					if sameItemKey(li.(map[string]interface{}), v.(map[string]interface{}), currentField.ItemKeyField) {
+						items = append(items,
+							getValue(collectionType.ElementType(), v, li, fieldsMap, currentField, service),
+						)
+						break
 					}
 				}
-
-				localCollection := local.([]interface{})
-
-				for _, li := range localCollection {
-					liMap := li.(map[string]interface{})
-					if keyL, ok := liMap[keyField]; ok {
-						if keyV, ok := vMap[keyField]; ok {
-							if keyL == keyV {
-								items = append(items,
-									getValue(collectionType.ElementType(), v, li, fieldsMap, currentField, service),
-								)
-							}
-						}
-					}
-				}
-			} else {
+			}
+		} else {
+			for _, v := range value.([]interface{}) {
 				items = append(items,
 					getValue(collectionType.ElementType(), v, v, fieldsMap, currentField, service),
 				)
@@ -332,10 +459,7 @@ func getValueFromAttrValue(av attr.Value, fieldsMap map[string]common.ConfigFiel
 		result := make(map[string]interface{})
 		for an, av := range v.Attributes() {
 			if !av.IsUnknown() && !av.IsNull() {
-				cf := fieldsMap[an]
-				if scf, ok := fieldsMap[an+"_"+service]; ok {
-					cf = scf
-				}
+				cf, _ := serviceField(fieldsMap, an, service)
 				result[an] = getValueFromAttrValue(av, cf.ItemFields, &cf, service)
 			}
 		}
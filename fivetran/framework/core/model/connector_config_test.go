@@ -0,0 +1,33 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/common"
+)
+
+func TestPrepareConfigAuthPatchServiceSpecificKeyedList(t *testing.T) {
+	fields := map[string]common.ConfigField{
+		"reports": {FieldValueType: common.ObjectList},
+		"reports_custom_service": {
+			FieldValueType: common.ObjectList,
+			ItemKeyField:   "table",
+			ItemFields:     map[string]common.ConfigField{"table": {FieldValueType: common.String}},
+		},
+	}
+	state := map[string]interface{}{"reports": []interface{}{
+		map[string]interface{}{"table": "a"},
+		map[string]interface{}{"table": "b"},
+	}}
+	plan := map[string]interface{}{"reports": []interface{}{
+		map[string]interface{}{"table": "b"},
+		map[string]interface{}{"table": "a"},
+	}}
+
+	if patch := PrepareConfigAuthPatch(state, plan, "custom_service", fields); len(patch) != 0 {
+		t.Errorf("reordering of keyed items of service specific field should not be a change, got %v", patch)
+	}
+	if patch := PrepareConfigAuthPatch(state, plan, "other_service", fields); len(patch) != 1 {
+		t.Errorf("reordering of items without key field should be a change, got %v", patch)
+	}
+}
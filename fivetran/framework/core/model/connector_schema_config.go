@@ -103,9 +103,10 @@ func (d *ConnectorSchemaResourceModel) ReadFromResponse(response connectors.Conn
 	}
 
 	tableAttrTypes := map[string]attr.Type{
-		"name":      types.StringType,
-		"enabled":   types.BoolType,
-		"sync_mode": types.StringType,
+		"name":             types.StringType,
+		"enabled":          types.BoolType,
+		"sync_mode":        types.StringType,
+		"resync_on_change": types.BoolType,
 		"column": types.SetType{
 			ElemType: types.ObjectType{
 				AttrTypes: columnAttrTypes,
@@ -172,6 +173,12 @@ func (d *ConnectorSchemaResourceModel) ReadFromResponse(response connectors.Conn
 				}
 			}
 
+			if resync, ok := localTable["resync_on_change"].(bool); ok {
+				tableElements["resync_on_change"] = types.BoolValue(resync)
+			} else {
+				tableElements["resync_on_change"] = types.BoolNull()
+			}
+
 			if _, ok := localTable["enabled"]; ok {
 				tableElements["enabled"] = types.BoolValue(helpers.StrToBool(tableMap["enabled"].(string)))
 			} else {
@@ -233,6 +240,11 @@ func (d *ConnectorSchemaResourceModel) getSchemas(checkUnknowns bool) []interfac
 						table["sync_mode"] = syncModeValue.ValueString()
 					}
 
+					resyncValue := tableElement.Attributes()["resync_on_change"].(basetypes.BoolValue)
+					if !resyncValue.IsUnknown() && !resyncValue.IsNull() {
+						table["resync_on_change"] = resyncValue.ValueBool()
+					}
+
 					enabledValue := tableElement.Attributes()["enabled"].(basetypes.BoolValue)
 					if (!enabledValue.IsUnknown() && !enabledValue.IsNull()) || !checkUnknowns {
 						table["enabled"] = enabledValue.ValueBool()
@@ -0,0 +1,25 @@
+package model
+
+import (
+	"github.com/fivetran/go-fivetran/connectors"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type ConnectorSync struct {
+	Id                types.String   `tfsdk:"id"`
+	ConnectorId       types.String   `tfsdk:"connector_id"`
+	Triggers          types.Map      `tfsdk:"triggers"`
+	HistoricalResync  types.Bool     `tfsdk:"historical_resync"`
+	WaitForCompletion types.Bool     `tfsdk:"wait_for_completion"`
+	SucceededAt       types.String   `tfsdk:"succeeded_at"`
+	FailedAt          types.String   `tfsdk:"failed_at"`
+	Timeouts          timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (d *ConnectorSync) ReadFromResponse(response connectors.DetailsWithCustomConfigNoTestsResponse) {
+	d.Id = types.StringValue(response.Data.ID)
+	d.ConnectorId = types.StringValue(response.Data.ID)
+	d.SucceededAt = types.StringValue(response.Data.SucceededAt.String())
+	d.FailedAt = types.StringValue(response.Data.FailedAt.String())
+}
@@ -0,0 +1,78 @@
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/fivetran/go-fivetran/connectors"
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/common"
+)
+
+func connectorResponseForTest(t *testing.T, connectedBy, tokenExpiresAt string) connectors.DetailsWithCustomConfigNoTestsResponse {
+	var response connectors.DetailsWithCustomConfigNoTestsResponse
+	err := json.Unmarshal([]byte(fmt.Sprintf(`
+	{
+		"code": "Success",
+		"data": {
+			"id": "connector_id",
+			"group_id": "group_id",
+			"service": "pinterest_ads",
+			"schema": "schema_name",
+			"connected_by": "%v",
+			"config": {
+				"refresh_token_expires_at": "%v"
+			}
+		}
+	}`, connectedBy, tokenExpiresAt)), &response)
+	if err != nil {
+		t.Fatalf("unable to parse response: %v", err)
+	}
+	return response
+}
+
+func TestConnectorCheckCredentialsChanged(t *testing.T) {
+	common.LoadConfigFieldsMap()
+	common.LoadAuthFieldsMap()
+	common.LocaDestinationFieldsMap()
+
+	now, _ := time.Parse(time.RFC3339, "2024-01-20T00:00:00Z")
+
+	var data ConnectorResourceModel
+	read := func(connectedBy, tokenExpiresAt string) []byte {
+		response := connectorResponseForTest(t, connectedBy, tokenExpiresAt)
+		data.ReadFromResponse(response)
+		return ReadAuthTimestamps(response.Data.Config)
+	}
+	timestamps := read("user_1", "2024-01-25T00:00:00Z")
+
+	// the token is refreshed by Fivetran before its expiration, the connector is not re-authenticated
+	connectedBy, previous := data.ConnectedBy, timestamps
+	timestamps = read("user_1", "2024-03-01T00:00:00Z")
+	if diags := data.checkCredentialsChanged(connectedBy, previous, timestamps, now); len(diags) != 0 {
+		t.Errorf("refreshed token should not be reported, got %v", diags)
+	}
+
+	previous = timestamps
+	timestamps = read("user_1", "2024-06-01T00:00:00Z")
+	if diags := data.checkCredentialsChanged(connectedBy, previous, timestamps, now); diags.WarningsCount() != 1 {
+		t.Errorf("token replaced long before its expiration should be reported with a warning, got %v", diags)
+	}
+
+	previous = timestamps
+	timestamps = read("user_1", "2024-05-01T00:00:00Z")
+	if diags := data.checkCredentialsChanged(connectedBy, previous, timestamps, now); diags.WarningsCount() != 1 {
+		t.Errorf("earlier token expiration should be reported with a warning, got %v", diags)
+	}
+
+	previous = timestamps
+	timestamps = read("user_2", "2024-05-01T00:00:00Z")
+	if diags := data.checkCredentialsChanged(connectedBy, previous, timestamps, now); diags.WarningsCount() != 1 {
+		t.Errorf("connector connected by another user should be reported with a warning, got %v", diags)
+	}
+
+	if diags := data.checkCredentialsChanged(data.ConnectedBy, nil, timestamps, now); len(diags) != 0 {
+		t.Errorf("timestamps should not be checked before the first read, got %v", diags)
+	}
+}
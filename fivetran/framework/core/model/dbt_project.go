@@ -0,0 +1,248 @@
+package model
+
+import (
+	"context"
+
+	"github.com/fivetran/go-fivetran/dbt"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	dbtProjectConfigAttrTypes = map[string]attr.Type{
+		"git_remote_url": types.StringType,
+		"git_branch":     types.StringType,
+		"folder_path":    types.StringType,
+	}
+	dbtModelAttrTypes = map[string]attr.Type{
+		"id":         types.StringType,
+		"model_name": types.StringType,
+		"scheduled":  types.BoolType,
+	}
+	dbtProjectItemAttrTypes = map[string]attr.Type{
+		"id":            types.StringType,
+		"group_id":      types.StringType,
+		"created_at":    types.StringType,
+		"created_by_id": types.StringType,
+	}
+)
+
+type DbtProjectConfig struct {
+	GitRemoteUrl types.String `tfsdk:"git_remote_url"`
+	GitBranch    types.String `tfsdk:"git_branch"`
+	FolderPath   types.String `tfsdk:"folder_path"`
+}
+
+type DbtProjectResourceModel struct {
+	Id              types.String   `tfsdk:"id"`
+	GroupId         types.String   `tfsdk:"group_id"`
+	DefaultSchema   types.String   `tfsdk:"default_schema"`
+	DbtVersion      types.String   `tfsdk:"dbt_version"`
+	EnvironmentVars types.Set      `tfsdk:"environment_vars"`
+	TargetName      types.String   `tfsdk:"target_name"`
+	Threads         types.Int64    `tfsdk:"threads"`
+	Type            types.String   `tfsdk:"type"`
+	Status          types.String   `tfsdk:"status"`
+	CreatedAt       types.String   `tfsdk:"created_at"`
+	CreatedById     types.String   `tfsdk:"created_by_id"`
+	PublicKey       types.String   `tfsdk:"public_key"`
+	ProjectConfig   types.List     `tfsdk:"project_config"`
+	Models          types.Set      `tfsdk:"models"`
+	EnsureReadiness types.Bool     `tfsdk:"ensure_readiness"`
+	Timeouts        timeouts.Value `tfsdk:"timeouts"`
+}
+
+type DbtProjectDatasourceModel struct {
+	Id              types.String `tfsdk:"id"`
+	GroupId         types.String `tfsdk:"group_id"`
+	DefaultSchema   types.String `tfsdk:"default_schema"`
+	DbtVersion      types.String `tfsdk:"dbt_version"`
+	EnvironmentVars types.Set    `tfsdk:"environment_vars"`
+	TargetName      types.String `tfsdk:"target_name"`
+	Threads         types.Int64  `tfsdk:"threads"`
+	Type            types.String `tfsdk:"type"`
+	Status          types.String `tfsdk:"status"`
+	CreatedAt       types.String `tfsdk:"created_at"`
+	CreatedById     types.String `tfsdk:"created_by_id"`
+	PublicKey       types.String `tfsdk:"public_key"`
+	ProjectConfig   types.List   `tfsdk:"project_config"`
+	Models          types.Set    `tfsdk:"models"`
+}
+
+// GetProjectConfig returns the values of the project_config block, all values are null when the block is not defined
+func (d *DbtProjectResourceModel) GetProjectConfig(ctx context.Context) DbtProjectConfig {
+	result := []DbtProjectConfig{}
+	if !d.ProjectConfig.IsNull() && !d.ProjectConfig.IsUnknown() {
+		d.ProjectConfig.ElementsAs(ctx, &result, false)
+	}
+	if len(result) == 0 {
+		return DbtProjectConfig{
+			GitRemoteUrl: types.StringNull(),
+			GitBranch:    types.StringNull(),
+			FolderPath:   types.StringNull(),
+		}
+	}
+	return result[0]
+}
+
+func (d *DbtProjectResourceModel) GetEnvironmentVars() []string {
+	result := []string{}
+	if d.EnvironmentVars.IsNull() || d.EnvironmentVars.IsUnknown() {
+		return result
+	}
+	for _, v := range d.EnvironmentVars.Elements() {
+		result = append(result, v.(types.String).ValueString())
+	}
+	return result
+}
+
+func (d *DbtProjectResourceModel) ReadFromResponse(resp dbt.DbtProjectDetailsResponse) {
+	c := readDbtProjectContainer(resp, d.EnvironmentVars, d.Models)
+	d.Id = c.Id
+	d.GroupId = c.GroupId
+	d.DefaultSchema = c.DefaultSchema
+	d.DbtVersion = c.DbtVersion
+	d.EnvironmentVars = c.EnvironmentVars
+	d.TargetName = c.TargetName
+	d.Threads = c.Threads
+	d.Type = c.Type
+	d.Status = c.Status
+	d.CreatedAt = c.CreatedAt
+	d.CreatedById = c.CreatedById
+	d.PublicKey = c.PublicKey
+	d.ProjectConfig = c.ProjectConfig
+	d.Models = c.Models
+}
+
+func (d *DbtProjectResourceModel) ReadModelsFromResponse(resp dbt.DbtModelsListResponse) {
+	d.Models = dbtModelsValue(resp)
+}
+
+func (d *DbtProjectDatasourceModel) ReadFromResponse(resp dbt.DbtProjectDetailsResponse) {
+	c := readDbtProjectContainer(resp, d.EnvironmentVars, d.Models)
+	d.Id = c.Id
+	d.GroupId = c.GroupId
+	d.DefaultSchema = c.DefaultSchema
+	d.DbtVersion = c.DbtVersion
+	d.EnvironmentVars = c.EnvironmentVars
+	d.TargetName = c.TargetName
+	d.Threads = c.Threads
+	d.Type = c.Type
+	d.Status = c.Status
+	d.CreatedAt = c.CreatedAt
+	d.CreatedById = c.CreatedById
+	d.PublicKey = c.PublicKey
+	d.ProjectConfig = c.ProjectConfig
+	d.Models = c.Models
+}
+
+func (d *DbtProjectDatasourceModel) ReadModelsFromResponse(resp dbt.DbtModelsListResponse) {
+	d.Models = dbtModelsValue(resp)
+}
+
+type dbtProjectContainer struct {
+	Id              types.String
+	GroupId         types.String
+	DefaultSchema   types.String
+	DbtVersion      types.String
+	EnvironmentVars types.Set
+	TargetName      types.String
+	Threads         types.Int64
+	Type            types.String
+	Status          types.String
+	CreatedAt       types.String
+	CreatedById     types.String
+	PublicKey       types.String
+	ProjectConfig   types.List
+	Models          types.Set
+}
+
+func readDbtProjectContainer(resp dbt.DbtProjectDetailsResponse, currentEnvironmentVars, currentModels types.Set) dbtProjectContainer {
+	c := dbtProjectContainer{
+		Id:            types.StringValue(resp.Data.ID),
+		GroupId:       types.StringValue(resp.Data.GroupId),
+		DefaultSchema: types.StringValue(resp.Data.DefaultSchema),
+		DbtVersion:    types.StringValue(resp.Data.DbtVersion),
+		Threads:       types.Int64Value(int64(resp.Data.Threads)),
+		Type:          types.StringValue(resp.Data.Type),
+		Status:        types.StringValue(resp.Data.Status),
+		CreatedAt:     types.StringValue(resp.Data.CreatedAt),
+		CreatedById:   types.StringValue(resp.Data.CreatedById),
+		PublicKey:     types.StringValue(resp.Data.PublicKey),
+		Models:        currentModels,
+	}
+
+	if resp.Data.TargetName == "" {
+		c.TargetName = types.StringNull()
+	} else {
+		c.TargetName = types.StringValue(resp.Data.TargetName)
+	}
+
+	if len(resp.Data.EnvironmentVars) == 0 && currentEnvironmentVars.IsNull() {
+		c.EnvironmentVars = types.SetNull(types.StringType)
+	} else {
+		vars := []attr.Value{}
+		for _, v := range resp.Data.EnvironmentVars {
+			vars = append(vars, types.StringValue(v))
+		}
+		c.EnvironmentVars, _ = types.SetValue(types.StringType, vars)
+	}
+
+	config, _ := types.ObjectValue(dbtProjectConfigAttrTypes,
+		map[string]attr.Value{
+			"git_remote_url": types.StringValue(resp.Data.ProjectConfig.GitRemoteUrl),
+			"git_branch":     types.StringValue(resp.Data.ProjectConfig.GitBranch),
+			"folder_path":    types.StringValue(resp.Data.ProjectConfig.FolderPath),
+		})
+	c.ProjectConfig, _ = types.ListValue(types.ObjectType{AttrTypes: dbtProjectConfigAttrTypes}, []attr.Value{config})
+
+	// models are listed only for the ready projects, the known ones are kept until then
+	if currentModels.IsNull() || currentModels.IsUnknown() {
+		c.Models, _ = types.SetValue(types.ObjectType{AttrTypes: dbtModelAttrTypes}, []attr.Value{})
+	}
+	return c
+}
+
+type DbtModels struct {
+	ProjectId types.String `tfsdk:"project_id"`
+	Models    types.Set    `tfsdk:"models"`
+}
+
+func (d *DbtModels) ReadFromResponse(resp dbt.DbtModelsListResponse) {
+	d.Models = dbtModelsValue(resp)
+}
+
+type DbtProjects struct {
+	Projects types.Set `tfsdk:"projects"`
+}
+
+func (d *DbtProjects) ReadFromResponse(resp dbt.DbtProjectsListResponse) {
+	items := []attr.Value{}
+	for _, v := range resp.Data.Items {
+		item, _ := types.ObjectValue(dbtProjectItemAttrTypes,
+			map[string]attr.Value{
+				"id":            types.StringValue(v.ID),
+				"group_id":      types.StringValue(v.GroupId),
+				"created_at":    types.StringValue(v.CreatedAt),
+				"created_by_id": types.StringValue(v.CreatedById),
+			})
+		items = append(items, item)
+	}
+	d.Projects, _ = types.SetValue(types.ObjectType{AttrTypes: dbtProjectItemAttrTypes}, items)
+}
+
+func dbtModelsValue(resp dbt.DbtModelsListResponse) types.Set {
+	items := []attr.Value{}
+	for _, v := range resp.Data.Items {
+		item, _ := types.ObjectValue(dbtModelAttrTypes,
+			map[string]attr.Value{
+				"id":         types.StringValue(v.ID),
+				"model_name": types.StringValue(v.ModelName),
+				"scheduled":  types.BoolValue(v.Scheduled),
+			})
+		items = append(items, item)
+	}
+	result, _ := types.SetValue(types.ObjectType{AttrTypes: dbtModelAttrTypes}, items)
+	return result
+}
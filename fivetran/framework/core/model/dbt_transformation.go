@@ -0,0 +1,159 @@
+package model
+
+import (
+	"context"
+
+	"github.com/fivetran/go-fivetran/dbt"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var dbtTransformationScheduleAttrTypes = map[string]attr.Type{
+	"schedule_type": types.StringType,
+	"days_of_week":  types.SetType{ElemType: types.StringType},
+	"interval":      types.Int64Type,
+	"time_of_day":   types.StringType,
+}
+
+type DbtTransformationSchedule struct {
+	ScheduleType types.String `tfsdk:"schedule_type"`
+	DaysOfWeek   types.Set    `tfsdk:"days_of_week"`
+	Interval     types.Int64  `tfsdk:"interval"`
+	TimeOfDay    types.String `tfsdk:"time_of_day"`
+}
+
+type DbtTransformationResourceModel struct {
+	Id              types.String   `tfsdk:"id"`
+	DbtProjectId    types.String   `tfsdk:"dbt_project_id"`
+	DbtModelName    types.String   `tfsdk:"dbt_model_name"`
+	RunTests        types.Bool     `tfsdk:"run_tests"`
+	Paused          types.Bool     `tfsdk:"paused"`
+	DbtModelId      types.String   `tfsdk:"dbt_model_id"`
+	OutputModelName types.String   `tfsdk:"output_model_name"`
+	CreatedAt       types.String   `tfsdk:"created_at"`
+	ConnectorIds    types.Set      `tfsdk:"connector_ids"`
+	ModelIds        types.Set      `tfsdk:"model_ids"`
+	Schedule        types.List     `tfsdk:"schedule"`
+	Timeouts        timeouts.Value `tfsdk:"timeouts"`
+}
+
+type DbtTransformationDatasourceModel struct {
+	Id              types.String `tfsdk:"id"`
+	DbtProjectId    types.String `tfsdk:"dbt_project_id"`
+	DbtModelName    types.String `tfsdk:"dbt_model_name"`
+	RunTests        types.Bool   `tfsdk:"run_tests"`
+	Paused          types.Bool   `tfsdk:"paused"`
+	DbtModelId      types.String `tfsdk:"dbt_model_id"`
+	OutputModelName types.String `tfsdk:"output_model_name"`
+	CreatedAt       types.String `tfsdk:"created_at"`
+	ConnectorIds    types.Set    `tfsdk:"connector_ids"`
+	ModelIds        types.Set    `tfsdk:"model_ids"`
+	Schedule        types.List   `tfsdk:"schedule"`
+}
+
+// GetSchedule returns the values of the schedule block, all values are null when the block is not defined
+func (d *DbtTransformationResourceModel) GetSchedule(ctx context.Context) DbtTransformationSchedule {
+	result := []DbtTransformationSchedule{}
+	if !d.Schedule.IsNull() && !d.Schedule.IsUnknown() {
+		d.Schedule.ElementsAs(ctx, &result, false)
+	}
+	if len(result) == 0 {
+		return DbtTransformationSchedule{
+			ScheduleType: types.StringNull(),
+			DaysOfWeek:   types.SetNull(types.StringType),
+			Interval:     types.Int64Null(),
+			TimeOfDay:    types.StringNull(),
+		}
+	}
+	return result[0]
+}
+
+func (d *DbtTransformationSchedule) GetDaysOfWeek() []string {
+	result := []string{}
+	if d.DaysOfWeek.IsNull() || d.DaysOfWeek.IsUnknown() {
+		return result
+	}
+	for _, v := range d.DaysOfWeek.Elements() {
+		result = append(result, v.(types.String).ValueString())
+	}
+	return result
+}
+
+func (d *DbtTransformationResourceModel) ReadFromResponse(resp dbt.DbtTransformationResponse, modelResp dbt.DbtModelDetailsResponse) {
+	c := readDbtTransformationContainer(resp, modelResp)
+	d.Id = c.Id
+	d.DbtProjectId = c.DbtProjectId
+	d.DbtModelName = c.DbtModelName
+	d.RunTests = c.RunTests
+	d.Paused = c.Paused
+	d.DbtModelId = c.DbtModelId
+	d.OutputModelName = c.OutputModelName
+	d.CreatedAt = c.CreatedAt
+	d.ConnectorIds = c.ConnectorIds
+	d.ModelIds = c.ModelIds
+	d.Schedule = c.Schedule
+}
+
+func (d *DbtTransformationDatasourceModel) ReadFromResponse(resp dbt.DbtTransformationResponse, modelResp dbt.DbtModelDetailsResponse) {
+	c := readDbtTransformationContainer(resp, modelResp)
+	d.Id = c.Id
+	d.DbtProjectId = c.DbtProjectId
+	d.DbtModelName = c.DbtModelName
+	d.RunTests = c.RunTests
+	d.Paused = c.Paused
+	d.DbtModelId = c.DbtModelId
+	d.OutputModelName = c.OutputModelName
+	d.CreatedAt = c.CreatedAt
+	d.ConnectorIds = c.ConnectorIds
+	d.ModelIds = c.ModelIds
+	d.Schedule = c.Schedule
+}
+
+type dbtTransformationContainer struct {
+	Id              types.String
+	DbtProjectId    types.String
+	DbtModelName    types.String
+	RunTests        types.Bool
+	Paused          types.Bool
+	DbtModelId      types.String
+	OutputModelName types.String
+	CreatedAt       types.String
+	ConnectorIds    types.Set
+	ModelIds        types.Set
+	Schedule        types.List
+}
+
+func readDbtTransformationContainer(resp dbt.DbtTransformationResponse, modelResp dbt.DbtModelDetailsResponse) dbtTransformationContainer {
+	c := dbtTransformationContainer{
+		Id:              types.StringValue(resp.Data.ID),
+		DbtProjectId:    types.StringValue(resp.Data.DbtProjectId),
+		DbtModelName:    types.StringValue(modelResp.Data.ModelName),
+		RunTests:        types.BoolValue(resp.Data.RunTests),
+		Paused:          types.BoolValue(resp.Data.Paused),
+		DbtModelId:      types.StringValue(resp.Data.DbtModelId),
+		OutputModelName: types.StringValue(resp.Data.OutputModelName),
+		CreatedAt:       types.StringValue(resp.Data.CreatedAt),
+		ConnectorIds:    stringSetValue(resp.Data.ConnectorIds),
+		ModelIds:        stringSetValue(resp.Data.ModelIds),
+	}
+
+	schedule, _ := types.ObjectValue(dbtTransformationScheduleAttrTypes,
+		map[string]attr.Value{
+			"schedule_type": types.StringValue(resp.Data.Schedule.ScheduleType),
+			"days_of_week":  stringSetValue(resp.Data.Schedule.DaysOfWeek),
+			"interval":      types.Int64Value(int64(resp.Data.Schedule.Interval)),
+			"time_of_day":   types.StringValue(resp.Data.Schedule.TimeOfDay),
+		})
+	c.Schedule, _ = types.ListValue(types.ObjectType{AttrTypes: dbtTransformationScheduleAttrTypes}, []attr.Value{schedule})
+	return c
+}
+
+func stringSetValue(values []string) types.Set {
+	items := []attr.Value{}
+	for _, v := range values {
+		items = append(items, types.StringValue(v))
+	}
+	result, _ := types.SetValue(types.StringType, items)
+	return result
+}
@@ -1,9 +1,13 @@
 package model
 
 import (
+	gfcommon "github.com/fivetran/go-fivetran/common"
 	"github.com/fivetran/go-fivetran/destinations"
 	"github.com/fivetran/terraform-provider-fivetran/fivetran/common"
+	"github.com/fivetran/terraform-provider-fivetran/modules/fivetranapi"
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 )
@@ -16,12 +20,20 @@ type DestinationResourceModel struct {
 	TimeZoneOffset types.String `tfsdk:"time_zone_offset"`
 	SetupStatus    types.String `tfsdk:"setup_status"`
 
-	Config   types.Object   `tfsdk:"config"`
-	Timeouts timeouts.Value `tfsdk:"timeouts"`
+	Config     types.Object   `tfsdk:"config"`
+	ConfigJson types.String   `tfsdk:"config_json"`
+	Timeouts   timeouts.Value `tfsdk:"timeouts"`
 
-	RunSetupTests     types.Bool `tfsdk:"run_setup_tests"`
-	TrustCertificates types.Bool `tfsdk:"trust_certificates"`
-	TrustFingerprints types.Bool `tfsdk:"trust_fingerprints"`
+	RunSetupTests          types.Bool `tfsdk:"run_setup_tests"`
+	TrustCertificates      types.Bool `tfsdk:"trust_certificates"`
+	TrustFingerprints      types.Bool `tfsdk:"trust_fingerprints"`
+	FailOnSetupTestFailure types.Bool `tfsdk:"fail_on_setup_test_failure"`
+
+	NetworkingMethod        types.String `tfsdk:"networking_method"`
+	PrivateLinkId           types.String `tfsdk:"private_link_id"`
+	HybridDeploymentAgentId types.String `tfsdk:"hybrid_deployment_agent_id"`
+
+	SetupTests types.List `tfsdk:"setup_tests"`
 }
 
 var _ destinationModel = &DestinationResourceModel{}
@@ -73,21 +85,79 @@ func (d *DestinationResourceModel) ReadFromResponse(resp destinations.Destinatio
 func (d *DestinationResourceModel) ReadFromResponseWithTests(resp destinations.DestinationDetailsWithSetupTestsCustomResponse) {
 	var model destinationModel = d
 	readFromResponse(model, resp.Data.DestinationDetailsBase, resp.Data.Config)
+	d.ReadSetupTests(resp.Data.SetupTests)
 }
 
 func (d *DestinationResourceModel) ReadFromLegacyResponse(resp destinations.DestinationDetailsWithSetupTestsResponse) {
 	var model destinationModel = d
 	readFromResponse(model, resp.Data.DestinationDetailsBase, map[string]interface{}{})
+	d.ReadSetupTests(resp.Data.SetupTests)
+}
+
+func (d *DestinationResourceModel) ReadSetupTests(tests []gfcommon.SetupTestResponse) {
+	d.SetupTests = readSetupTests(tests)
 }
 
+// GetFailedSetupTests returns descriptions of the failed setup tests
+func (d *DestinationResourceModel) GetFailedSetupTests() []string {
+	return getFailedSetupTests(d.SetupTests)
+}
+
+// GetConfigMap returns `config` fields merged with fields from `config_json`
 func (d *DestinationResourceModel) GetConfigMap(nullOnNull bool) (map[string]interface{}, error) {
-	if d.Config.IsNull() && nullOnNull {
+	if d.Config.IsNull() && d.ConfigJson.IsNull() && nullOnNull {
 		return nil, nil
 	}
 	result := getValueFromAttrValue(d.Config, common.GetDestinationFieldsMap(), nil, d.Service.ValueString()).(map[string]interface{})
 	serviceName := d.Service.ValueString()
 	serviceFields := common.GetDestinationFieldsForService(serviceName)
 	allFields := common.GetDestinationFieldsMap()
-	err := patchServiceSpecificFields(result, serviceName, serviceFields, allFields)
-	return result, err
+	if err := patchServiceSpecificFields(result, serviceName, serviceFields, allFields); err != nil {
+		return result, err
+	}
+	return mergeConfigJson(result, d.ConfigJson, "config_json")
+}
+
+// ValidateConfig checks config fields against the fields available for the destination service
+func (d *DestinationResourceModel) ValidateConfig() diag.Diagnostics {
+	if d.Service.IsNull() || d.Service.IsUnknown() {
+		return nil
+	}
+	serviceName := d.Service.ValueString()
+	serviceFields := common.GetDestinationFieldsForService(serviceName)
+	if len(serviceFields) == 0 {
+		// there is no fields metadata for the service, only JSON can be checked
+		return validateConfigWithJson(types.ObjectNull(nil), path.Root("config"), d.ConfigJson, path.Root("config_json"), serviceName, serviceFields)
+	}
+	return validateConfigWithJson(d.Config, path.Root("config"), d.ConfigJson, path.Root("config_json"), serviceName, serviceFields)
+}
+
+// HasNetworking reports whether `networking_method`, `private_link_id` or `hybrid_deployment_agent_id` is set in the configuration
+func (d *DestinationResourceModel) HasNetworking() bool {
+	return d.networking().isSet()
+}
+
+// NetworkingChanged reports whether configured networking fields differ from the state
+func (d *DestinationResourceModel) NetworkingChanged(state DestinationResourceModel) bool {
+	return d.networking().changed(state.networking())
+}
+
+func (d *DestinationResourceModel) GetNetworkingModifyRequest() fivetranapi.NetworkingModifyRequest {
+	return d.networking().getModifyRequest()
+}
+
+// ReadNetworking refreshes networking fields managed in the configuration
+func (d *DestinationResourceModel) ReadNetworking(resp fivetranapi.NetworkingResponse) {
+	d.NetworkingMethod = readNetworkingValue(d.NetworkingMethod, resp.Data.NetworkingMethod)
+	d.PrivateLinkId = readNetworkingValue(d.PrivateLinkId, resp.Data.PrivateLinkId)
+	d.HybridDeploymentAgentId = readNetworkingValue(d.HybridDeploymentAgentId, resp.Data.HybridDeploymentAgentId)
+}
+
+func (d *DestinationResourceModel) networking() networkingFields {
+	return networkingFields{
+		NetworkingMethod:        d.NetworkingMethod,
+		PrivateLinkId:           d.PrivateLinkId,
+		ProxyAgentId:            types.StringNull(),
+		HybridDeploymentAgentId: d.HybridDeploymentAgentId,
+	}
 }
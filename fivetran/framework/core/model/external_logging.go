@@ -0,0 +1,180 @@
+package model
+
+import (
+	externallogging "github.com/fivetran/go-fivetran/external_logging"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var externalLoggingConfigAttrTypes = map[string]attr.Type{
+	"workspace_id":   types.StringType,
+	"primary_key":    types.StringType,
+	"log_group_name": types.StringType,
+	"role_arn":       types.StringType,
+	"external_id":    types.StringType,
+	"region":         types.StringType,
+	"api_key":        types.StringType,
+	"sub_domain":     types.StringType,
+	"host":           types.StringType,
+	"hostname":       types.StringType,
+	"port":           types.Int64Type,
+	"channel":        types.StringType,
+	"enable_ssl":     types.BoolType,
+	"token":          types.StringType,
+	"project_id":     types.StringType,
+}
+
+// externalLoggingSensitiveFields are returned masked by the REST API
+var externalLoggingSensitiveFields = map[string]bool{
+	"primary_key": true,
+	"api_key":     true,
+	"token":       true,
+}
+
+type ExternalLoggingResourceModel struct {
+	Id            types.String `tfsdk:"id"`
+	GroupId       types.String `tfsdk:"group_id"`
+	Service       types.String `tfsdk:"service"`
+	Enabled       types.Bool   `tfsdk:"enabled"`
+	RunSetupTests types.Bool   `tfsdk:"run_setup_tests"`
+	Config        types.List   `tfsdk:"config"`
+}
+
+type ExternalLoggingDatasourceModel struct {
+	Id      types.String `tfsdk:"id"`
+	GroupId types.String `tfsdk:"group_id"`
+	Service types.String `tfsdk:"service"`
+	Enabled types.Bool   `tfsdk:"enabled"`
+	Config  types.List   `tfsdk:"config"`
+}
+
+// GetConfigMap returns the config values for the request: the values set in the configuration
+// and zero values for the ones removed from it since the previous state (if defined)
+func (d *ExternalLoggingResourceModel) GetConfigMap(previous *ExternalLoggingResourceModel) map[string]interface{} {
+	current := externalLoggingConfigAttributes(d.Config)
+	prior := map[string]attr.Value{}
+	if previous != nil {
+		prior = externalLoggingConfigAttributes(previous.Config)
+	}
+
+	result := map[string]interface{}{}
+	for k, v := range current {
+		if !v.IsNull() && !v.IsUnknown() {
+			result[k] = externalLoggingRequestValue(v, false)
+			continue
+		}
+		if p, ok := prior[k]; ok && !p.IsNull() && !p.IsUnknown() {
+			result[k] = externalLoggingRequestValue(p, true)
+		}
+	}
+	return result
+}
+
+func (d *ExternalLoggingResourceModel) ReadFromResponse(resp externallogging.ExternalLoggingResponse) {
+	d.Id = types.StringValue(resp.Data.Id)
+	d.Service = types.StringValue(resp.Data.Service)
+	d.Enabled = types.BoolValue(resp.Data.Enabled)
+	if d.RunSetupTests.IsNull() || d.RunSetupTests.IsUnknown() {
+		d.RunSetupTests = types.BoolValue(false)
+	}
+	// group_id is not returned by the REST API, the known value is kept
+	d.Config = readExternalLoggingConfig(resp, d.Config, true)
+}
+
+func (d *ExternalLoggingDatasourceModel) ReadFromResponse(resp externallogging.ExternalLoggingResponse) {
+	d.Id = types.StringValue(resp.Data.Id)
+	d.GroupId = types.StringNull()
+	d.Service = types.StringValue(resp.Data.Service)
+	d.Enabled = types.BoolValue(resp.Data.Enabled)
+	d.Config = readExternalLoggingConfig(resp, types.ListNull(types.ObjectType{AttrTypes: externalLoggingConfigAttrTypes}), false)
+}
+
+// readExternalLoggingConfig maps the config from the response: masked sensitive values are taken from the current config
+// if possible, zero values of the attributes that are not set in the current config are kept as null if nullIfZero is set
+func readExternalLoggingConfig(resp externallogging.ExternalLoggingResponse, currentConfig types.List, nullIfZero bool) types.List {
+	current := externalLoggingConfigAttributes(currentConfig)
+	upstream := map[string]attr.Value{
+		"workspace_id":   types.StringValue(resp.Data.Config.WorkspaceId),
+		"primary_key":    types.StringValue(resp.Data.Config.PrimaryKey),
+		"log_group_name": types.StringValue(resp.Data.Config.LogGroupName),
+		"role_arn":       types.StringValue(resp.Data.Config.RoleArn),
+		"external_id":    types.StringValue(resp.Data.Config.ExternalId),
+		"region":         types.StringValue(resp.Data.Config.Region),
+		"api_key":        types.StringValue(resp.Data.Config.ApiKey),
+		"sub_domain":     types.StringValue(resp.Data.Config.SubDomain),
+		"host":           types.StringValue(resp.Data.Config.Host),
+		"hostname":       types.StringValue(resp.Data.Config.Hostname),
+		"port":           types.Int64Value(int64(resp.Data.Config.Port)),
+		"channel":        types.StringValue(resp.Data.Config.Channel),
+		"enable_ssl":     types.BoolValue(resp.Data.Config.EnableSsl),
+		"token":          types.StringValue(resp.Data.Config.Token),
+		"project_id":     types.StringValue(resp.Data.Config.ProjectId),
+	}
+
+	values := map[string]attr.Value{}
+	for k, v := range upstream {
+		currentValue, hasCurrent := current[k]
+		switch {
+		case externalLoggingSensitiveFields[k] && hasCurrent:
+			values[k] = currentValue
+		case nullIfZero && (!hasCurrent || currentValue.IsNull()) && isZeroAttrValue(v):
+			values[k] = nullAttrValue(externalLoggingConfigAttrTypes[k])
+		default:
+			values[k] = v
+		}
+	}
+
+	config, _ := types.ObjectValue(externalLoggingConfigAttrTypes, values)
+	result, _ := types.ListValue(types.ObjectType{AttrTypes: externalLoggingConfigAttrTypes}, []attr.Value{config})
+	return result
+}
+
+func externalLoggingConfigAttributes(config types.List) map[string]attr.Value {
+	if config.IsNull() || config.IsUnknown() || len(config.Elements()) == 0 {
+		return map[string]attr.Value{}
+	}
+	if item, ok := config.Elements()[0].(types.Object); ok && !item.IsNull() && !item.IsUnknown() {
+		return item.Attributes()
+	}
+	return map[string]attr.Value{}
+}
+
+func externalLoggingRequestValue(value attr.Value, zero bool) interface{} {
+	switch v := value.(type) {
+	case types.Int64:
+		if zero {
+			return 0
+		}
+		return int(v.ValueInt64())
+	case types.Bool:
+		return !zero && v.ValueBool()
+	case types.String:
+		if zero {
+			return ""
+		}
+		return v.ValueString()
+	}
+	return nil
+}
+
+func isZeroAttrValue(value attr.Value) bool {
+	switch v := value.(type) {
+	case types.Int64:
+		return v.ValueInt64() == 0
+	case types.Bool:
+		return !v.ValueBool()
+	case types.String:
+		return v.ValueString() == ""
+	}
+	return false
+}
+
+func nullAttrValue(t attr.Type) attr.Value {
+	switch t {
+	case types.Int64Type:
+		return types.Int64Null()
+	case types.BoolType:
+		return types.BoolNull()
+	}
+	return types.StringNull()
+}
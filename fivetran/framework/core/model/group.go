@@ -0,0 +1,18 @@
+package model
+
+import (
+	"github.com/fivetran/go-fivetran/groups"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type Group struct {
+	Id        types.String `tfsdk:"id"`
+	Name      types.String `tfsdk:"name"`
+	CreatedAt types.String `tfsdk:"created_at"`
+}
+
+func (d *Group) ReadFromResponse(resp groups.GroupDetailsResponse) {
+	d.Id = types.StringValue(resp.Data.ID)
+	d.Name = types.StringValue(resp.Data.Name)
+	d.CreatedAt = types.StringValue(resp.Data.CreatedAt.String())
+}
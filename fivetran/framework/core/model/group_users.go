@@ -0,0 +1,76 @@
+package model
+
+import (
+	"fmt"
+
+	"github.com/fivetran/go-fivetran/groups"
+	"github.com/fivetran/go-fivetran/users"
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var groupUserAttrTypes = map[string]attr.Type{
+	"id":    types.StringType,
+	"email": types.StringType,
+	"role":  types.StringType,
+}
+
+type GroupUsers struct {
+	Id            types.String `tfsdk:"id"`
+	GroupId       types.String `tfsdk:"group_id"`
+	Authoritative types.Bool   `tfsdk:"authoritative"`
+	User          types.Set    `tfsdk:"user"`
+}
+
+// IsAuthoritative reports whether the resource manages all the users of the group, true by default
+func (d *GroupUsers) IsAuthoritative() bool {
+	return core.GetBoolOrDefault(d.Authoritative, true)
+}
+
+// GetRoles returns roles of users defined in the configuration mapped by user email
+func (d *GroupUsers) GetRoles() map[string]string {
+	return getMembershipRoles(d.User, "email")
+}
+
+// ReadFromResponse reads users of the group omitting the group creator,
+// in non-authoritative mode only the users already managed by the resource are read
+func (d *GroupUsers) ReadFromResponse(groupId string, resp groups.GroupListUsersResponse) {
+	authoritative := d.IsAuthoritative()
+	managedRoles := d.GetRoles()
+	items := []attr.Value{}
+	for _, v := range resp.Data.Items {
+		if v.Role == "" {
+			continue
+		}
+		if _, managed := managedRoles[v.Email]; !authoritative && !managed {
+			continue
+		}
+		item, _ := types.ObjectValue(groupUserAttrTypes, map[string]attr.Value{
+			"id":    types.StringValue(v.ID),
+			"email": types.StringValue(v.Email),
+			"role":  types.StringValue(v.Role),
+		})
+		items = append(items, item)
+	}
+	d.Id = types.StringValue(groupId)
+	d.GroupId = types.StringValue(groupId)
+	d.Authoritative = types.BoolValue(authoritative)
+	d.User, _ = types.SetValue(types.ObjectType{AttrTypes: groupUserAttrTypes}, items)
+}
+
+type GroupUser struct {
+	Id      types.String `tfsdk:"id"`
+	GroupId types.String `tfsdk:"group_id"`
+	Email   types.String `tfsdk:"email"`
+	Role    types.String `tfsdk:"role"`
+	UserId  types.String `tfsdk:"user_id"`
+}
+
+func (d *GroupUser) ReadFromResponse(groupId string, user users.UserDetailsData) {
+	d.Id = types.StringValue(fmt.Sprintf("%v:%v", groupId, user.Email))
+	d.GroupId = types.StringValue(groupId)
+	d.Email = types.StringValue(user.Email)
+	d.Role = types.StringValue(user.Role)
+	d.UserId = types.StringValue(user.ID)
+}
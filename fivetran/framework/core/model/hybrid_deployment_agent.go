@@ -0,0 +1,49 @@
+package model
+
+import (
+	"github.com/fivetran/terraform-provider-fivetran/modules/fivetranapi"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type HybridDeploymentAgent struct {
+	Id                    types.String `tfsdk:"id"`
+	GroupId               types.String `tfsdk:"group_id"`
+	DisplayName           types.String `tfsdk:"display_name"`
+	EnvType               types.String `tfsdk:"env_type"`
+	AuthType              types.String `tfsdk:"auth_type"`
+	AcceptTerms           types.Bool   `tfsdk:"accept_terms"`
+	AuthenticationCounter types.Int64  `tfsdk:"authentication_counter"`
+	RegisteredAt          types.String `tfsdk:"registered_at"`
+	Token                 types.String `tfsdk:"token"`
+	ConfigFile            types.String `tfsdk:"config_file"`
+	AuthFile              types.String `tfsdk:"auth_file"`
+}
+
+// ReadFromCreateResponse reads agent details and the agent credentials returned on creation and re-authentication
+func (d *HybridDeploymentAgent) ReadFromCreateResponse(resp fivetranapi.HybridDeploymentAgentCreateResponse) {
+	d.readDetails(resp.Data.HybridDeploymentAgentDetails)
+	d.Token = types.StringValue(resp.Data.Token)
+	d.ConfigFile = types.StringValue(resp.Data.Files.ConfigJson)
+	d.AuthFile = types.StringValue(resp.Data.Files.AuthJson)
+}
+
+// ReadFromResponse reads agent details, the credentials are not returned by the details API
+func (d *HybridDeploymentAgent) ReadFromResponse(resp fivetranapi.HybridDeploymentAgentResponse) {
+	d.readDetails(resp.Data)
+	if d.Token.IsUnknown() {
+		d.Token = types.StringNull()
+	}
+	if d.ConfigFile.IsUnknown() {
+		d.ConfigFile = types.StringNull()
+	}
+	if d.AuthFile.IsUnknown() {
+		d.AuthFile = types.StringNull()
+	}
+}
+
+func (d *HybridDeploymentAgent) readDetails(details fivetranapi.HybridDeploymentAgentDetails) {
+	d.Id = types.StringValue(details.Id)
+	d.GroupId = types.StringValue(details.GroupId)
+	d.DisplayName = types.StringValue(details.DisplayName)
+	d.RegisteredAt = types.StringValue(details.RegisteredAt)
+}
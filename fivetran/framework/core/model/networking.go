@@ -0,0 +1,55 @@
+package model
+
+import (
+	"github.com/fivetran/terraform-provider-fivetran/modules/fivetranapi"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// networkingFields are connection method fields of connectors and destinations,
+// the fields are managed by the provider only when they are set in the configuration
+type networkingFields struct {
+	NetworkingMethod        types.String
+	PrivateLinkId           types.String
+	ProxyAgentId            types.String
+	HybridDeploymentAgentId types.String
+}
+
+func (f networkingFields) isSet() bool {
+	return isKnownValue(f.NetworkingMethod) || isKnownValue(f.PrivateLinkId) || isKnownValue(f.ProxyAgentId) ||
+		isKnownValue(f.HybridDeploymentAgentId)
+}
+
+func (f networkingFields) changed(state networkingFields) bool {
+	return isKnownValue(f.NetworkingMethod) && !f.NetworkingMethod.Equal(state.NetworkingMethod) ||
+		isKnownValue(f.PrivateLinkId) && !f.PrivateLinkId.Equal(state.PrivateLinkId) ||
+		isKnownValue(f.ProxyAgentId) && !f.ProxyAgentId.Equal(state.ProxyAgentId) ||
+		isKnownValue(f.HybridDeploymentAgentId) && !f.HybridDeploymentAgentId.Equal(state.HybridDeploymentAgentId)
+}
+
+func (f networkingFields) getModifyRequest() fivetranapi.NetworkingModifyRequest {
+	return fivetranapi.NetworkingModifyRequest{
+		NetworkingMethod:        getKnownStringPointer(f.NetworkingMethod),
+		PrivateLinkId:           getKnownStringPointer(f.PrivateLinkId),
+		ProxyAgentId:            getKnownStringPointer(f.ProxyAgentId),
+		HybridDeploymentAgentId: getKnownStringPointer(f.HybridDeploymentAgentId),
+	}
+}
+
+func readNetworkingValue(current types.String, value string) types.String {
+	if current.IsNull() {
+		return current
+	}
+	return types.StringValue(value)
+}
+
+func getKnownStringPointer(value types.String) *string {
+	if !isKnownValue(value) {
+		return nil
+	}
+	result := value.ValueString()
+	return &result
+}
+
+func isKnownValue(value types.String) bool {
+	return !value.IsNull() && !value.IsUnknown()
+}
@@ -0,0 +1,136 @@
+package model
+
+import (
+	privatelinks "github.com/fivetran/go-fivetran/private_links"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type PrivateLink struct {
+	Id            types.String   `tfsdk:"id"`
+	Name          types.String   `tfsdk:"name"`
+	Region        types.String   `tfsdk:"region"`
+	Service       types.String   `tfsdk:"service"`
+	Config        types.Map      `tfsdk:"config"`
+	CloudProvider types.String   `tfsdk:"cloud_provider"`
+	State         types.String   `tfsdk:"state"`
+	StateSummary  types.String   `tfsdk:"state_summary"`
+	CreatedAt     types.String   `tfsdk:"created_at"`
+	CreatedBy     types.String   `tfsdk:"created_by"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
+}
+
+// privateLinkConfigFields maps the supported config fields to the config builder setters
+var privateLinkConfigFields = map[string]func(*privatelinks.PrivateLinksConfig, string) *privatelinks.PrivateLinksConfig{
+	"connection_service_name": (*privatelinks.PrivateLinksConfig).ConnectionServiceName,
+	"connection_service_id":   (*privatelinks.PrivateLinksConfig).ConnectionServiceId,
+	"workspace_url":           (*privatelinks.PrivateLinksConfig).WorkspaceUrl,
+	"account_name":            (*privatelinks.PrivateLinksConfig).AccountName,
+	"account_url":             (*privatelinks.PrivateLinksConfig).AccountUrl,
+	"vpce_id":                 (*privatelinks.PrivateLinksConfig).VpceId,
+	"pls_id":                  (*privatelinks.PrivateLinksConfig).PlsId,
+	"aws_account_id":          (*privatelinks.PrivateLinksConfig).AwsAccountId,
+	"cluster_identifier":      (*privatelinks.PrivateLinksConfig).ClusterIdentifier,
+	"sub_resource_name":       (*privatelinks.PrivateLinksConfig).SubResourceName,
+}
+
+func (d *PrivateLink) ReadFromResponse(resp privatelinks.PrivateLinksResponse) {
+	d.Id = types.StringValue(resp.Data.Id)
+	d.Name = types.StringValue(resp.Data.Name)
+	d.Region = types.StringValue(resp.Data.Region)
+	d.Service = types.StringValue(resp.Data.Service)
+	d.CloudProvider = types.StringValue(resp.Data.CloudProvider)
+	d.State = types.StringValue(resp.Data.State)
+	d.StateSummary = types.StringValue(resp.Data.StateSummary)
+	d.CreatedAt = types.StringValue(resp.Data.CreatedAt)
+	d.CreatedBy = types.StringValue(resp.Data.CreatedBy)
+	d.Config = d.readConfig(resp.Data.Config)
+}
+
+func (d *PrivateLink) GetConfig() *privatelinks.PrivateLinksConfig {
+	result := &privatelinks.PrivateLinksConfig{}
+	if d.Config.IsNull() || d.Config.IsUnknown() {
+		return result
+	}
+	for k, v := range d.Config.Elements() {
+		if setter, ok := privateLinkConfigFields[k]; ok {
+			setter(result, v.(types.String).ValueString())
+		}
+	}
+	return result
+}
+
+// readConfig reads only the config fields managed in the configuration, all non-empty fields are read on import
+func (d *PrivateLink) readConfig(resp privatelinks.PrivateLinksConfigResponse) types.Map {
+	config := map[string]string{
+		"connection_service_name": resp.ConnectionServiceName,
+		"connection_service_id":   resp.ConnectionServiceId,
+		"workspace_url":           resp.WorkspaceUrl,
+		"account_name":            resp.AccountName,
+		"account_url":             resp.AccountUrl,
+		"vpce_id":                 resp.VpceId,
+		"pls_id":                  resp.PlsId,
+		"aws_account_id":          resp.AwsAccountId,
+		"cluster_identifier":      resp.ClusterIdentifier,
+		"sub_resource_name":       resp.SubResourceName,
+	}
+	items := map[string]attr.Value{}
+	if d.Config.IsNull() || d.Config.IsUnknown() {
+		for k, v := range config {
+			if v != "" {
+				items[k] = types.StringValue(v)
+			}
+		}
+	} else {
+		for k, current := range d.Config.Elements() {
+			if v := config[k]; v != "" {
+				items[k] = types.StringValue(v)
+			} else {
+				// the field is not returned (e.g. sensitive value), keep the configured one
+				items[k] = current
+			}
+		}
+	}
+	result, _ := types.MapValue(types.StringType, items)
+	return result
+}
+
+type PrivateLinks struct {
+	PrivateLinks types.List `tfsdk:"private_links"`
+}
+
+var (
+	privateLinkItemAttrTypes = map[string]attr.Type{
+		"id":             types.StringType,
+		"name":           types.StringType,
+		"region":         types.StringType,
+		"service":        types.StringType,
+		"cloud_provider": types.StringType,
+		"state":          types.StringType,
+		"state_summary":  types.StringType,
+		"created_at":     types.StringType,
+		"created_by":     types.StringType,
+	}
+)
+
+func (d *PrivateLinks) ReadFromResponse(items []privatelinks.PrivateLinksResponseBase) {
+	elementType := types.ObjectType{AttrTypes: privateLinkItemAttrTypes}
+	values := []attr.Value{}
+	for _, v := range items {
+		item, _ := types.ObjectValue(privateLinkItemAttrTypes,
+			map[string]attr.Value{
+				"id":             types.StringValue(v.Id),
+				"name":           types.StringValue(v.Name),
+				"region":         types.StringValue(v.Region),
+				"service":        types.StringValue(v.Service),
+				"cloud_provider": types.StringValue(v.CloudProvider),
+				"state":          types.StringValue(v.State),
+				"state_summary":  types.StringValue(v.StateSummary),
+				"created_at":     types.StringValue(v.CreatedAt),
+				"created_by":     types.StringValue(v.CreatedBy),
+			})
+		values = append(values, item)
+	}
+	d.PrivateLinks, _ = types.ListValue(elementType, values)
+}
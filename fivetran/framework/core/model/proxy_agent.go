@@ -0,0 +1,73 @@
+package model
+
+import (
+	"github.com/fivetran/terraform-provider-fivetran/modules/fivetranapi"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type ProxyAgent struct {
+	Id             types.String `tfsdk:"id"`
+	DisplayName    types.String `tfsdk:"display_name"`
+	GroupRegion    types.String `tfsdk:"group_region"`
+	Token          types.String `tfsdk:"token"`
+	ProxyServerUri types.String `tfsdk:"proxy_server_uri"`
+	AccountId      types.String `tfsdk:"account_id"`
+	CreatedBy      types.String `tfsdk:"created_by"`
+	RegisteredAt   types.String `tfsdk:"registered_at"`
+}
+
+func (d *ProxyAgent) ReadFromCreateResponse(resp fivetranapi.ProxyAgentCreateResponse) {
+	d.Id = types.StringValue(resp.Data.AgentId)
+	d.Token = types.StringValue(resp.Data.AuthToken)
+	d.ProxyServerUri = types.StringValue(resp.Data.ProxyServerUri)
+}
+
+// ReadFromResponse reads agent details, the token and the proxy server URI are returned only on creation
+func (d *ProxyAgent) ReadFromResponse(resp fivetranapi.ProxyAgentResponse) {
+	d.Id = types.StringValue(resp.Data.Id)
+	d.DisplayName = types.StringValue(resp.Data.DisplayName)
+	d.GroupRegion = types.StringValue(resp.Data.Region)
+	d.AccountId = types.StringValue(resp.Data.AccountId)
+	d.CreatedBy = types.StringValue(resp.Data.CreatedBy)
+	d.RegisteredAt = types.StringValue(resp.Data.RegisteredAt)
+	if d.Token.IsUnknown() {
+		d.Token = types.StringNull()
+	}
+	if d.ProxyServerUri.IsUnknown() {
+		d.ProxyServerUri = types.StringNull()
+	}
+}
+
+type ProxyAgents struct {
+	ProxyAgents types.List `tfsdk:"proxy_agents"`
+}
+
+var (
+	proxyAgentItemAttrTypes = map[string]attr.Type{
+		"id":            types.StringType,
+		"display_name":  types.StringType,
+		"group_region":  types.StringType,
+		"account_id":    types.StringType,
+		"created_by":    types.StringType,
+		"registered_at": types.StringType,
+	}
+)
+
+func (d *ProxyAgents) ReadFromResponse(items []fivetranapi.ProxyAgentDetails) {
+	elementType := types.ObjectType{AttrTypes: proxyAgentItemAttrTypes}
+	values := []attr.Value{}
+	for _, v := range items {
+		item, _ := types.ObjectValue(proxyAgentItemAttrTypes,
+			map[string]attr.Value{
+				"id":            types.StringValue(v.Id),
+				"display_name":  types.StringValue(v.DisplayName),
+				"group_region":  types.StringValue(v.Region),
+				"account_id":    types.StringValue(v.AccountId),
+				"created_by":    types.StringValue(v.CreatedBy),
+				"registered_at": types.StringValue(v.RegisteredAt),
+			})
+		values = append(values, item)
+	}
+	d.ProxyAgents, _ = types.ListValue(elementType, values)
+}
@@ -0,0 +1,56 @@
+package model
+
+import (
+	"fmt"
+
+	gfcommon "github.com/fivetran/go-fivetran/common"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	setupTestAttrTypes = map[string]attr.Type{
+		"title":   types.StringType,
+		"status":  types.StringType,
+		"message": types.StringType,
+	}
+)
+
+func readSetupTests(tests []gfcommon.SetupTestResponse) types.List {
+	elementType := types.ObjectType{AttrTypes: setupTestAttrTypes}
+	items := []attr.Value{}
+	for _, t := range tests {
+		item, _ := types.ObjectValue(setupTestAttrTypes,
+			map[string]attr.Value{
+				"title":   types.StringValue(t.Title),
+				"status":  types.StringValue(t.Status),
+				"message": types.StringValue(t.Message),
+			})
+		items = append(items, item)
+	}
+	result, _ := types.ListValue(elementType, items)
+	return result
+}
+
+// getFailedSetupTests returns descriptions of the setup tests with FAILED or JOB_FAILED status
+func getFailedSetupTests(tests types.List) []string {
+	result := []string{}
+	if tests.IsNull() || tests.IsUnknown() {
+		return result
+	}
+	for _, e := range tests.Elements() {
+		test, ok := e.(types.Object)
+		if !ok {
+			continue
+		}
+		attrs := test.Attributes()
+		status := attrs["status"].(types.String).ValueString()
+		if status == "FAILED" || status == "JOB_FAILED" {
+			result = append(result, fmt.Sprintf("%v: %v; status: %v",
+				attrs["title"].(types.String).ValueString(),
+				attrs["message"].(types.String).ValueString(),
+				status))
+		}
+	}
+	return result
+}
@@ -0,0 +1,25 @@
+package model
+
+import (
+	"github.com/fivetran/go-fivetran/teams"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type Team struct {
+	Id          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Role        types.String `tfsdk:"role"`
+}
+
+func (d *Team) ReadFromResponse(resp teams.TeamsDetailsResponse) {
+	d.Id = types.StringValue(resp.Data.Id)
+	d.Name = types.StringValue(resp.Data.Name)
+	d.Role = types.StringValue(resp.Data.Role)
+
+	if resp.Data.Description == "" {
+		d.Description = types.StringNull()
+	} else {
+		d.Description = types.StringValue(resp.Data.Description)
+	}
+}
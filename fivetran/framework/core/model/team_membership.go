@@ -0,0 +1,231 @@
+package model
+
+import (
+	"strings"
+
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	teamConnectorMembershipAttrTypes = map[string]attr.Type{
+		"connector_id": types.StringType,
+		"role":         types.StringType,
+		"created_at":   types.StringType,
+	}
+	teamGroupMembershipAttrTypes = map[string]attr.Type{
+		"group_id":   types.StringType,
+		"role":       types.StringType,
+		"created_at": types.StringType,
+	}
+	teamUserMembershipAttrTypes = map[string]attr.Type{
+		"user_id": types.StringType,
+		"role":    types.StringType,
+	}
+)
+
+type TeamConnectorMembership struct {
+	Id        types.String `tfsdk:"id"`
+	TeamId    types.String `tfsdk:"team_id"`
+	Connector types.Set    `tfsdk:"connector"`
+}
+
+type TeamGroupMembership struct {
+	Id     types.String `tfsdk:"id"`
+	TeamId types.String `tfsdk:"team_id"`
+	Group  types.Set    `tfsdk:"group"`
+}
+
+type TeamUserMembership struct {
+	Id     types.String `tfsdk:"id"`
+	TeamId types.String `tfsdk:"team_id"`
+	User   types.Set    `tfsdk:"user"`
+}
+
+// Memberships is the model of a resource managing all the memberships of a single member type of a team or a user
+type Memberships interface {
+	GetOwnerId() string
+	GetRoles() map[string]string
+	ReadFromResponse(ownerId string, items []core.Membership)
+}
+
+func (d *TeamConnectorMembership) GetOwnerId() string {
+	return d.TeamId.ValueString()
+}
+
+// GetRoles returns roles of connectors defined in the configuration mapped by connector id
+func (d *TeamConnectorMembership) GetRoles() map[string]string {
+	return getMembershipRoles(d.Connector, "connector_id")
+}
+
+func (d *TeamConnectorMembership) ReadFromResponse(teamId string, items []core.Membership) {
+	d.Id = types.StringValue(teamId)
+	d.TeamId = types.StringValue(teamId)
+	d.Connector = readMemberships(items, "connector_id", teamConnectorMembershipAttrTypes)
+}
+
+func (d *TeamGroupMembership) GetOwnerId() string {
+	return d.TeamId.ValueString()
+}
+
+// GetRoles returns roles of groups defined in the configuration mapped by group id
+func (d *TeamGroupMembership) GetRoles() map[string]string {
+	return getMembershipRoles(d.Group, "group_id")
+}
+
+func (d *TeamGroupMembership) ReadFromResponse(teamId string, items []core.Membership) {
+	d.Id = types.StringValue(teamId)
+	d.TeamId = types.StringValue(teamId)
+	d.Group = readMemberships(items, "group_id", teamGroupMembershipAttrTypes)
+}
+
+func (d *TeamUserMembership) GetOwnerId() string {
+	return d.TeamId.ValueString()
+}
+
+// GetRoles returns roles of users defined in the configuration mapped by user id
+func (d *TeamUserMembership) GetRoles() map[string]string {
+	return getMembershipRoles(d.User, "user_id")
+}
+
+func (d *TeamUserMembership) ReadFromResponse(teamId string, items []core.Membership) {
+	d.Id = types.StringValue(teamId)
+	d.TeamId = types.StringValue(teamId)
+	d.User = readMemberships(items, "user_id", teamUserMembershipAttrTypes)
+}
+
+// readMemberships maps the memberships to the set of the membership blocks, created_at is set only if defined in attrTypes
+func readMemberships(items []core.Membership, idField string, attrTypes map[string]attr.Type) types.Set {
+	result := []attr.Value{}
+	for _, v := range items {
+		// memberships inherited from the account role are not managed by the resources
+		if v.Role == "" {
+			continue
+		}
+		values := map[string]attr.Value{
+			idField: types.StringValue(v.MemberId),
+			"role":  types.StringValue(v.Role),
+		}
+		if _, ok := attrTypes["created_at"]; ok {
+			values["created_at"] = types.StringValue(v.CreatedAt)
+		}
+		item, _ := types.ObjectValue(attrTypes, values)
+		result = append(result, item)
+	}
+	set, _ := types.SetValue(types.ObjectType{AttrTypes: attrTypes}, result)
+	return set
+}
+
+func getMembershipRoles(memberships types.Set, idField string) map[string]string {
+	result := make(map[string]string)
+	if memberships.IsNull() || memberships.IsUnknown() {
+		return result
+	}
+	for _, v := range memberships.Elements() {
+		attrs := v.(types.Object).Attributes()
+		result[attrs[idField].(types.String).ValueString()] = attrs["role"].(types.String).ValueString()
+	}
+	return result
+}
+
+type TeamUser struct {
+	Id     types.String `tfsdk:"id"`
+	TeamId types.String `tfsdk:"team_id"`
+	UserId types.String `tfsdk:"user_id"`
+	Role   types.String `tfsdk:"role"`
+}
+
+type TeamGroupAccess struct {
+	Id        types.String `tfsdk:"id"`
+	TeamId    types.String `tfsdk:"team_id"`
+	GroupId   types.String `tfsdk:"group_id"`
+	Role      types.String `tfsdk:"role"`
+	CreatedAt types.String `tfsdk:"created_at"`
+}
+
+type TeamConnectorAccess struct {
+	Id          types.String `tfsdk:"id"`
+	TeamId      types.String `tfsdk:"team_id"`
+	ConnectorId types.String `tfsdk:"connector_id"`
+	Role        types.String `tfsdk:"role"`
+	CreatedAt   types.String `tfsdk:"created_at"`
+}
+
+// Membership is the model of a resource managing a single membership of a team
+type Membership interface {
+	GetOwnerId() string
+	GetMemberId() string
+	GetRole() string
+	ReadFromResponse(ownerId string, item core.Membership)
+}
+
+func (d *TeamUser) GetOwnerId() string {
+	return d.TeamId.ValueString()
+}
+
+func (d *TeamUser) GetMemberId() string {
+	return d.UserId.ValueString()
+}
+
+func (d *TeamUser) GetRole() string {
+	return d.Role.ValueString()
+}
+
+func (d *TeamUser) ReadFromResponse(teamId string, item core.Membership) {
+	d.Id = types.StringValue(GetTeamMemberId(teamId, item.MemberId))
+	d.TeamId = types.StringValue(teamId)
+	d.UserId = types.StringValue(item.MemberId)
+	d.Role = types.StringValue(item.Role)
+}
+
+func (d *TeamGroupAccess) GetOwnerId() string {
+	return d.TeamId.ValueString()
+}
+
+func (d *TeamGroupAccess) GetMemberId() string {
+	return d.GroupId.ValueString()
+}
+
+func (d *TeamGroupAccess) GetRole() string {
+	return d.Role.ValueString()
+}
+
+func (d *TeamGroupAccess) ReadFromResponse(teamId string, item core.Membership) {
+	d.Id = types.StringValue(GetTeamMemberId(teamId, item.MemberId))
+	d.TeamId = types.StringValue(teamId)
+	d.GroupId = types.StringValue(item.MemberId)
+	d.Role = types.StringValue(item.Role)
+	d.CreatedAt = types.StringValue(item.CreatedAt)
+}
+
+func (d *TeamConnectorAccess) GetOwnerId() string {
+	return d.TeamId.ValueString()
+}
+
+func (d *TeamConnectorAccess) GetMemberId() string {
+	return d.ConnectorId.ValueString()
+}
+
+func (d *TeamConnectorAccess) GetRole() string {
+	return d.Role.ValueString()
+}
+
+func (d *TeamConnectorAccess) ReadFromResponse(teamId string, item core.Membership) {
+	d.Id = types.StringValue(GetTeamMemberId(teamId, item.MemberId))
+	d.TeamId = types.StringValue(teamId)
+	d.ConnectorId = types.StringValue(item.MemberId)
+	d.Role = types.StringValue(item.Role)
+	d.CreatedAt = types.StringValue(item.CreatedAt)
+}
+
+// GetTeamMemberId returns the identifier of a single team membership resource: `{team_id}:{member_id}`
+func GetTeamMemberId(teamId, memberId string) string {
+	return teamId + ":" + memberId
+}
+
+// ParseTeamMemberId splits the identifier of a single team membership resource into team id and member id
+func ParseTeamMemberId(id string) (teamId, memberId string, ok bool) {
+	teamId, memberId, found := strings.Cut(id, ":")
+	return teamId, memberId, found && teamId != "" && memberId != ""
+}
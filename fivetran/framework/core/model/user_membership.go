@@ -0,0 +1,48 @@
+package model
+
+import (
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type UserConnectorMembership struct {
+	Id        types.String `tfsdk:"id"`
+	UserId    types.String `tfsdk:"user_id"`
+	Connector types.Set    `tfsdk:"connector"`
+}
+
+type UserGroupMembership struct {
+	Id     types.String `tfsdk:"id"`
+	UserId types.String `tfsdk:"user_id"`
+	Group  types.Set    `tfsdk:"group"`
+}
+
+func (d *UserConnectorMembership) GetOwnerId() string {
+	return d.UserId.ValueString()
+}
+
+// GetRoles returns roles of connectors defined in the configuration mapped by connector id
+func (d *UserConnectorMembership) GetRoles() map[string]string {
+	return getMembershipRoles(d.Connector, "connector_id")
+}
+
+func (d *UserConnectorMembership) ReadFromResponse(userId string, items []core.Membership) {
+	d.Id = types.StringValue(userId)
+	d.UserId = types.StringValue(userId)
+	d.Connector = readMemberships(items, "connector_id", teamConnectorMembershipAttrTypes)
+}
+
+func (d *UserGroupMembership) GetOwnerId() string {
+	return d.UserId.ValueString()
+}
+
+// GetRoles returns roles of groups defined in the configuration mapped by group id
+func (d *UserGroupMembership) GetRoles() map[string]string {
+	return getMembershipRoles(d.Group, "group_id")
+}
+
+func (d *UserGroupMembership) ReadFromResponse(userId string, items []core.Membership) {
+	d.Id = types.StringValue(userId)
+	d.UserId = types.StringValue(userId)
+	d.Group = readMemberships(items, "group_id", teamGroupMembershipAttrTypes)
+}
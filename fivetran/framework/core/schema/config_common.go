@@ -39,19 +39,20 @@ func schemaBlockFromConfigField(cf common.ConfigField) resourceSchema.Block {
 	return nil
 }
 
-func buildDescription(fieldDescription map[string]string) string {
+func buildDescription(cf common.ConfigField) string {
 	var result []string
 
-	keys := make([]string, 0, len(fieldDescription))
-	for k := range fieldDescription {
+	keys := make([]string, 0, len(cf.Description))
+	for k := range cf.Description {
 		keys = append(keys, k)
 	}
 
 	sort.Strings(keys)
 
 	for _, service := range keys {
-		if fieldDescription[service] != "" {
-			result = append(result, fmt.Sprintf("\t- Service `%v`: %v", service, fieldDescription[service]))
+		serviceDescription := buildServiceDescription(cf, service)
+		if serviceDescription != "" {
+			result = append(result, fmt.Sprintf("\t- Service `%v`: %v", service, serviceDescription))
 		}
 	}
 	if len(result) > 0 {
@@ -61,29 +62,55 @@ func buildDescription(fieldDescription map[string]string) string {
 	}
 }
 
+// buildServiceDescription appends required flag, possible values, default value and value range to the field description for the service
+func buildServiceDescription(cf common.ConfigField, service string) string {
+	var result []string
+
+	if cf.Description[service] != "" {
+		result = append(result, cf.Description[service])
+	}
+	if cf.Required[service] {
+		result = append(result, "Required.")
+	}
+	if options, ok := cf.Enum[service]; ok && len(options) > 0 {
+		result = append(result, fmt.Sprintf("Possible values: `%v`.", strings.Join(options, "`, `")))
+	}
+	if defaultValue, ok := cf.Default[service]; ok {
+		result = append(result, fmt.Sprintf("Default value: `%v`.", defaultValue))
+	}
+	if minimum, ok := cf.Minimum[service]; ok {
+		result = append(result, fmt.Sprintf("Minimum value: `%v`.", minimum))
+	}
+	if maximum, ok := cf.Maximum[service]; ok {
+		result = append(result, fmt.Sprintf("Maximum value: `%v`.", maximum))
+	}
+
+	return strings.Join(result, " ")
+}
+
 func schemaAttributeFromConfigField(cf common.ConfigField, datasource bool) interface{} {
 	switch cf.FieldValueType {
 	case common.Boolean:
 		if datasource {
-			return datasourceSchema.BoolAttribute{Computed: true, Description: buildDescription(cf.Description)}
+			return datasourceSchema.BoolAttribute{Computed: true, Description: buildDescription(cf)}
 		} else {
-			return resourceSchema.BoolAttribute{Optional: !cf.Readonly, Computed: true, Description: buildDescription(cf.Description)}
+			return resourceSchema.BoolAttribute{Optional: !cf.Readonly, Computed: true, Description: buildDescription(cf)}
 		}
 	case common.Integer:
 		if datasource {
-			return datasourceSchema.Int64Attribute{Computed: true, Description: buildDescription(cf.Description)}
+			return datasourceSchema.Int64Attribute{Computed: true, Description: buildDescription(cf)}
 		} else {
-			return resourceSchema.Int64Attribute{Optional: !cf.Readonly, Computed: true, Description: buildDescription(cf.Description)}
+			return resourceSchema.Int64Attribute{Optional: !cf.Readonly, Computed: true, Description: buildDescription(cf)}
 		}
 	case common.String:
 		if datasource {
-			return datasourceSchema.StringAttribute{Computed: true, Sensitive: cf.Sensitive, Description: buildDescription(cf.Description)}
+			return datasourceSchema.StringAttribute{Computed: true, Sensitive: cf.Sensitive, Description: buildDescription(cf)}
 		} else {
 			return resourceSchema.StringAttribute{
 				Optional:    !cf.Readonly,
 				Computed:    cf.Readonly || !cf.Nullable,
 				Sensitive:   cf.Sensitive,
-				Description: buildDescription(cf.Description),
+				Description: buildDescription(cf),
 			}
 		}
 	case common.StringList:
@@ -92,7 +119,7 @@ func schemaAttributeFromConfigField(cf common.ConfigField, datasource bool) inte
 			return datasourceSchema.SetAttribute{
 				ElementType: elemType,
 				Computed:    true,
-				Description: buildDescription(cf.Description),
+				Description: buildDescription(cf),
 			}
 		} else {
 			return resourceSchema.SetAttribute{
@@ -100,7 +127,7 @@ func schemaAttributeFromConfigField(cf common.ConfigField, datasource bool) inte
 				Optional:    !cf.Readonly,
 				Computed:    cf.Readonly,
 				Sensitive:   cf.Sensitive,
-				Description: buildDescription(cf.Description),
+				Description: buildDescription(cf),
 			}
 		}
 	case common.ObjectList:
@@ -114,7 +141,7 @@ func schemaAttributeFromConfigField(cf common.ConfigField, datasource bool) inte
 				NestedObject: datasourceSchema.NestedAttributeObject{
 					Attributes: toDatasourceAttr(subFields),
 				},
-				Description: buildDescription(cf.Description),
+				Description: buildDescription(cf),
 			}
 		} else {
 			return resourceSchema.SetNestedAttribute{
@@ -123,7 +150,7 @@ func schemaAttributeFromConfigField(cf common.ConfigField, datasource bool) inte
 				NestedObject: resourceSchema.NestedAttributeObject{
 					Attributes: toResourceAttr(subFields),
 				},
-				Description: buildDescription(cf.Description),
+				Description: buildDescription(cf),
 			}
 		}
 	case common.Object:
@@ -135,14 +162,14 @@ func schemaAttributeFromConfigField(cf common.ConfigField, datasource bool) inte
 			return datasourceSchema.SingleNestedAttribute{
 				Computed:    true,
 				Attributes:  toDatasourceAttr(subFields),
-				Description: buildDescription(cf.Description),
+				Description: buildDescription(cf),
 			}
 		} else {
 			return resourceSchema.SingleNestedAttribute{
 				Optional:    !cf.Readonly,
 				Computed:    cf.Readonly,
 				Attributes:  toResourceAttr(subFields),
-				Description: buildDescription(cf.Description),
+				Description: buildDescription(cf),
 			}
 		}
 	}
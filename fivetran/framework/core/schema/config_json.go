@@ -0,0 +1,16 @@
+package schema
+
+import (
+	"fmt"
+
+	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+func configJsonResourceAttribute(block string) resourceSchema.StringAttribute {
+	return resourceSchema.StringAttribute{
+		Optional:  true,
+		Sensitive: true,
+		Description: fmt.Sprintf("The JSON-encoded object with `%v` fields that are not supported by the `%v` block yet. "+
+			"The keys should be specified using the REST API field names. A field can't be set in both `%v` and `%v_json`.", block, block, block, block),
+	}
+}
@@ -0,0 +1,58 @@
+package schema
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+)
+
+const (
+	connectCardIdDescription             = "The unique resource identifier (equals to `connector_id`)."
+	connectCardConnectorIdDescription    = "The unique identifier for the connector within the Fivetran system."
+	connectCardRedirectUriDescription    = "The URI on your site that the user is redirected to after the connector setup is finished in the Connect Card."
+	connectCardHideSetupGuideDescription = "Specifies whether the setup guide is hidden in the Connect Card. The default value is FALSE."
+	connectCardUriDescription            = "The Connect Card URI that should be handed to the data owner to authorize the connector."
+	connectCardTokenDescription          = "The Connect Card token."
+	connectCardTokenExpiresAtDescription = "The timestamp of the time the Connect Card token expires (RFC 3339). Empty if the expiration time is not provided by the token."
+)
+
+func GetConnectCardResourceSchema() schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: connectCardIdDescription,
+			},
+			"connector_id": schema.StringAttribute{
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Description:   connectCardConnectorIdDescription,
+			},
+			"redirect_uri": schema.StringAttribute{
+				Optional:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Description:   connectCardRedirectUriDescription,
+			},
+			"hide_setup_guide": schema.BoolAttribute{
+				Optional:      true,
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.RequiresReplace()},
+				Description:   connectCardHideSetupGuideDescription,
+			},
+			"uri": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: connectCardUriDescription,
+			},
+			"token": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: connectCardTokenDescription,
+			},
+			"token_expires_at": schema.StringAttribute{
+				Computed:    true,
+				Description: connectCardTokenExpiresAtDescription,
+			},
+		},
+	}
+}
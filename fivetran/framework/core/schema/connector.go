@@ -60,6 +60,31 @@ func ConnectorAttributesSchema() core.Schema {
 				Description:  "Specifies whether we should trust the SSH fingerprint automatically. The default value is FALSE. If a fingerprint is not trusted automatically, it has to be approved with [Certificates Management API Approve a destination fingerprint](https://fivetran.com/docs/rest-api/certificates#approveadestinationfingerprint).",
 				ResourceOnly: true,
 			},
+			"networking_method": {
+				ValueType:    core.String,
+				Description:  "The method used to connect to the source. Possible values: `Directly`, `SshTunnel`, `ProxyAgent`, `PrivateLink`. The field is managed only when it is set in the configuration.",
+				ResourceOnly: true,
+			},
+			"private_link_id": {
+				ValueType:    core.String,
+				Description:  "The private link ID (e.g. `fivetran_private_link.my_link.id`) used by the connector when `networking_method` is `PrivateLink`. The field is managed only when it is set in the configuration.",
+				ResourceOnly: true,
+			},
+			"proxy_agent_id": {
+				ValueType:    core.String,
+				Description:  "The proxy agent ID (e.g. `fivetran_proxy_agent.my_agent.id`) used by the connector when `networking_method` is `ProxyAgent`. The proxy agent is validated to exist on `terraform plan`. The field is managed only when it is set in the configuration.",
+				ResourceOnly: true,
+			},
+			"fail_on_setup_test_failure": {
+				ValueType:    core.Boolean,
+				Description:  "Specifies whether the apply should fail if any of the setup tests has `FAILED` or `JOB_FAILED` status. The setup tests results are available in the `setup_tests` attribute. The default value is FALSE.",
+				ResourceOnly: true,
+			},
+			"wait_for_setup": {
+				ValueType:    core.Boolean,
+				Description:  "Specifies whether the resource should wait on creation until the connector `setup_state` becomes `connected`. The creation fails if the setup state becomes `broken`. Waiting time is limited by `timeouts.create` (30 minutes by default). The default value is FALSE.",
+				ResourceOnly: true,
+			},
 
 			"succeeded_at": {
 				DatasourceOnly: true,
@@ -105,6 +130,19 @@ func ConnectorAttributesSchema() core.Schema {
 	}
 }
 
+func ConnectorResourceAttributes() map[string]resourceSchema.Attribute {
+	result := ConnectorAttributesSchema().GetResourceSchema()
+	result["setup_tests"] = setupTestsResourceAttribute()
+	result["config_json"] = configJsonResourceAttribute("config")
+	result["auth_json"] = configJsonResourceAttribute("auth")
+	result["credentials_version"] = resourceSchema.Int64Attribute{
+		Optional: true,
+		Description: "The version of the connector credentials. Change the value to send all the sensitive `config` and `auth` fields to the API again, " +
+			"e.g. after the connector was re-authenticated outside of Terraform.",
+	}
+	return result
+}
+
 func ConnectorResourceBlocks(ctx context.Context) map[string]resourceSchema.Block {
 	return map[string]resourceSchema.Block{
 		"destination_schema": resourceSchema.SingleNestedBlock{
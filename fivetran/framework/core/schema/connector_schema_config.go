@@ -83,6 +83,10 @@ func getTableBlock() schema.SetNestedBlock {
 						stringvalidator.OneOf("HISTORY", "SOFT_DELETE", "LIVE"),
 					},
 				},
+				"resync_on_change": schema.BoolAttribute{
+					Optional:    true,
+					Description: "Specifies whether the table data should be re-synced when the table configuration (`enabled`, `sync_mode` or columns) is changed by the resource. The default value is FALSE.",
+				},
 			},
 			Blocks: map[string]schema.Block{
 				"column": getColumnBlock(),
@@ -0,0 +1,57 @@
+package schema
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func GetConnectorSyncResourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The unique resource identifier (equals to `connector_id`).",
+			},
+			"connector_id": schema.StringAttribute{
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Description:   "The unique identifier for the connector within the Fivetran system.",
+			},
+			"triggers": schema.MapAttribute{
+				Optional:      true,
+				ElementType:   types.StringType,
+				PlanModifiers: []planmodifier.Map{mapplanmodifier.RequiresReplace()},
+				Description:   "A map of arbitrary strings that, when changed, will trigger a new sync of the connector.",
+			},
+			"historical_resync": schema.BoolAttribute{
+				Optional:      true,
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.RequiresReplace()},
+				Description:   "Specifies whether the triggered sync should re-sync all historical data. The default value is FALSE.",
+			},
+			"wait_for_completion": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Specifies whether the resource should wait until the triggered sync succeeds or fails. The default value is FALSE.",
+			},
+			"succeeded_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "The timestamp of the time the connector sync succeeded last time (known after the triggered sync finished if `wait_for_completion` is set).",
+			},
+			"failed_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "The timestamp of the time the connector sync failed last time (known after the triggered sync finished if `wait_for_completion` is set).",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+			}),
+		},
+	}
+}
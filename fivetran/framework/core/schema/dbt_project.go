@@ -0,0 +1,305 @@
+package schema
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	datasourceSchema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	dbtProjectIdDescription            = "The unique identifier for the dbt Project within the Fivetran system."
+	dbtProjectGroupIdDescription       = "The unique identifier for the group within the Fivetran system."
+	dbtProjectDefaultSchemaDescription = "Default schema in destination. This production schema will contain your transformed data."
+	dbtProjectDbtVersionDescription    = "The version of dbt that should run the project. We support the following versions: 0.18.0 - 0.18.2, 0.19.0 - 0.19.2, 0.20.0 - 0.20.2, 0.21.0 - 0.21.1, 1.0.0, 1.0.1, 1.0.3 - 1.0.9, 1.1.0 - 1.1.3, 1.2.0 - 1.2.4, 1.3.0 - 1.3.2, 1.4.1."
+	dbtProjectConfigDescription        = "Type specific dbt Project configuration parameters."
+	dbtProjectGitRemoteUrlDescription  = "Git remote URL with your dbt project."
+	dbtProjectGitBranchDescription     = "Git branch."
+	dbtProjectFolderPathDescription    = "Folder in Git repo with your dbt project."
+	dbtProjectEnvVarsDescription       = "List of environment variables defined as key-value pairs in the raw string format using `=` as a separator."
+	dbtProjectTargetNameDescription    = "Target name to set or override the value from the deployment.yaml"
+	dbtProjectThreadsDescription       = "The number of threads dbt will use (from 1 to 32). Make sure this value is compatible with your destination type. For example, Snowflake supports only 8 concurrent queries on an X-Small warehouse."
+	dbtProjectTypeDescription          = "Type of dbt Project. Currently only `GIT` supported. Empty value will be considered as default (GIT)."
+	dbtProjectStatusDescription        = "Status of dbt Project (NOT_READY, READY, ERROR)."
+	dbtProjectCreatedAtDescription     = "The timestamp of the dbt Project creation."
+	dbtProjectCreatedByIdDescription   = "The unique identifier for the User within the Fivetran system who created the dbt Project."
+	dbtProjectPublicKeyDescription     = "Public key to grant Fivetran SSH access to git repository."
+	dbtModelsDescription               = "The collection of dbt Models."
+)
+
+func DbtProjectResource(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: dbtProjectIdDescription,
+			},
+			"group_id": schema.StringAttribute{
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Description:   dbtProjectGroupIdDescription,
+			},
+			"default_schema": schema.StringAttribute{
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Description:   dbtProjectDefaultSchemaDescription,
+			},
+			"dbt_version": schema.StringAttribute{
+				Required:    true,
+				Description: dbtProjectDbtVersionDescription,
+			},
+			"environment_vars": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: dbtProjectEnvVarsDescription,
+			},
+			"target_name": schema.StringAttribute{
+				Optional:    true,
+				Description: dbtProjectTargetNameDescription,
+			},
+			"threads": schema.Int64Attribute{
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+				Description:   dbtProjectThreadsDescription,
+			},
+			"type": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: dbtProjectTypeDescription,
+			},
+			"ensure_readiness": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Should resource wait for project to finish initialization. Default value: true.",
+			},
+			"status": schema.StringAttribute{
+				Computed:    true,
+				Description: dbtProjectStatusDescription,
+			},
+			"created_at": schema.StringAttribute{
+				Computed:    true,
+				Description: dbtProjectCreatedAtDescription,
+			},
+			"created_by_id": schema.StringAttribute{
+				Computed:    true,
+				Description: dbtProjectCreatedByIdDescription,
+			},
+			"public_key": schema.StringAttribute{
+				Computed:    true,
+				Description: dbtProjectPublicKeyDescription,
+			},
+			"models": schema.SetNestedAttribute{
+				Computed:    true,
+				Description: dbtModelsDescription,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The unique identifier for the dbt Model within the Fivetran system.",
+						},
+						"model_name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The dbt Model name.",
+						},
+						"scheduled": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Boolean specifying whether the model is selected for execution.",
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"project_config": schema.ListNestedBlock{
+				Description: dbtProjectConfigDescription,
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"git_remote_url": schema.StringAttribute{
+							Optional: true,
+							Computed: true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.RequiresReplace(),
+								stringplanmodifier.UseStateForUnknown(),
+							},
+							Description: dbtProjectGitRemoteUrlDescription,
+						},
+						"git_branch": schema.StringAttribute{
+							Optional:      true,
+							Computed:      true,
+							PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+							Description:   dbtProjectGitBranchDescription,
+						},
+						"folder_path": schema.StringAttribute{
+							Optional:      true,
+							Computed:      true,
+							PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+							Description:   dbtProjectFolderPathDescription,
+						},
+					},
+				},
+			},
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+			}),
+		},
+	}
+}
+
+func DbtProjectDatasource() datasourceSchema.Schema {
+	return datasourceSchema.Schema{
+		Attributes: map[string]datasourceSchema.Attribute{
+			"id": datasourceSchema.StringAttribute{
+				Required:    true,
+				Description: dbtProjectIdDescription,
+			},
+			"group_id": datasourceSchema.StringAttribute{
+				Computed:    true,
+				Description: dbtProjectGroupIdDescription,
+			},
+			"default_schema": datasourceSchema.StringAttribute{
+				Computed:    true,
+				Description: dbtProjectDefaultSchemaDescription,
+			},
+			"dbt_version": datasourceSchema.StringAttribute{
+				Computed:    true,
+				Description: dbtProjectDbtVersionDescription,
+			},
+			"environment_vars": datasourceSchema.SetAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: dbtProjectEnvVarsDescription,
+			},
+			"target_name": datasourceSchema.StringAttribute{
+				Computed:    true,
+				Description: dbtProjectTargetNameDescription,
+			},
+			"threads": datasourceSchema.Int64Attribute{
+				Computed:    true,
+				Description: dbtProjectThreadsDescription,
+			},
+			"type": datasourceSchema.StringAttribute{
+				Computed:    true,
+				Description: dbtProjectTypeDescription,
+			},
+			"status": datasourceSchema.StringAttribute{
+				Computed:    true,
+				Description: dbtProjectStatusDescription,
+			},
+			"created_at": datasourceSchema.StringAttribute{
+				Computed:    true,
+				Description: dbtProjectCreatedAtDescription,
+			},
+			"created_by_id": datasourceSchema.StringAttribute{
+				Computed:    true,
+				Description: dbtProjectCreatedByIdDescription,
+			},
+			"public_key": datasourceSchema.StringAttribute{
+				Computed:    true,
+				Description: dbtProjectPublicKeyDescription,
+			},
+			"project_config": datasourceSchema.ListNestedAttribute{
+				Computed:    true,
+				Description: dbtProjectConfigDescription,
+				NestedObject: datasourceSchema.NestedAttributeObject{
+					Attributes: map[string]datasourceSchema.Attribute{
+						"git_remote_url": datasourceSchema.StringAttribute{
+							Computed:    true,
+							Description: dbtProjectGitRemoteUrlDescription,
+						},
+						"git_branch": datasourceSchema.StringAttribute{
+							Computed:    true,
+							Description: dbtProjectGitBranchDescription,
+						},
+						"folder_path": datasourceSchema.StringAttribute{
+							Computed:    true,
+							Description: dbtProjectFolderPathDescription,
+						},
+					},
+				},
+			},
+			"models": dbtModelsDatasourceAttribute(),
+		},
+	}
+}
+
+func DbtProjectsDatasource() datasourceSchema.Schema {
+	return datasourceSchema.Schema{
+		Attributes: map[string]datasourceSchema.Attribute{
+			"projects": datasourceSchema.SetNestedAttribute{
+				Computed:    true,
+				Description: "The collection of dbt Projects.",
+				NestedObject: datasourceSchema.NestedAttributeObject{
+					Attributes: map[string]datasourceSchema.Attribute{
+						"id": datasourceSchema.StringAttribute{
+							Computed:    true,
+							Description: "The unique identifier for the dbt project within the Fivetran system.",
+						},
+						"group_id": datasourceSchema.StringAttribute{
+							Computed:    true,
+							Description: "The name of the group within your account related to the project.",
+						},
+						"created_at": datasourceSchema.StringAttribute{
+							Computed:    true,
+							Description: "The timestamp of when the project was created in your account.",
+						},
+						"created_by_id": datasourceSchema.StringAttribute{
+							Computed:    true,
+							Description: "The unique identifier for the User within the Fivetran system who created the DBT Project.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func DbtModelsDatasource() datasourceSchema.Schema {
+	return datasourceSchema.Schema{
+		Attributes: map[string]datasourceSchema.Attribute{
+			"project_id": datasourceSchema.StringAttribute{
+				Required:    true,
+				Description: "The unique identifier for the dbt project within the Fivetran system.",
+			},
+			"models": dbtModelsDatasourceAttribute(),
+		},
+	}
+}
+
+func dbtModelsDatasourceAttribute() datasourceSchema.Attribute {
+	return datasourceSchema.SetNestedAttribute{
+		Computed:    true,
+		Description: dbtModelsDescription,
+		NestedObject: datasourceSchema.NestedAttributeObject{
+			Attributes: map[string]datasourceSchema.Attribute{
+				"id": datasourceSchema.StringAttribute{
+					Computed:    true,
+					Description: "The unique identifier for the dbt Model within the Fivetran system.",
+				},
+				"model_name": datasourceSchema.StringAttribute{
+					Computed:    true,
+					Description: "The dbt Model name.",
+				},
+				"scheduled": datasourceSchema.BoolAttribute{
+					Computed:    true,
+					Description: "Boolean specifying whether the model is selected for execution.",
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,193 @@
+package schema
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	datasourceSchema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	dbtTransformationIdDescription           = "The unique identifier for the dbt Transformation within the Fivetran system."
+	dbtTransformationProjectIdDescription    = "The unique identifier for the dbt Project within the Fivetran system."
+	dbtTransformationModelNameDescription    = "Target dbt Model name."
+	dbtTransformationRunTestsDescription     = "The field indicating whether the tests have been configured for dbt Transformation. By default, the value is false."
+	dbtTransformationPausedDescription       = "The field indicating whether the transformation will be created in paused state. By default, the value is false."
+	dbtTransformationScheduleDescription     = "dbt Transformation schedule parameters."
+	dbtTransformationScheduleTypeDescription = "The type of the schedule to run the dbt Transformation on. The following values are supported: INTEGRATED, TIME_OF_DAY, INTERVAL. For INTEGRATED schedule type, interval and time_of_day values are ignored and only the days_of_week parameter values are taken into account (but may be empty or null). For TIME_OF_DAY schedule type, the interval parameter value is ignored and the time_of_day values is taken into account along with days_of_week value. For INTERVAL schedule type, time_of_day value is ignored and the interval parameter value is taken into account along with days_of_week value."
+	dbtTransformationDaysOfWeekDescription   = "The set of the days of the week the transformation should be launched on. The following values are supported: MONDAY, TUESDAY, WEDNESDAY, THURSDAY, FRIDAY, SATURDAY, SUNDAY."
+	dbtTransformationIntervalDescription     = "The time interval in minutes between subsequent transformation runs."
+	dbtTransformationTimeOfDayDescription    = `The time of the day the transformation should be launched at. Supported values are: "00:00", "01:00", "02:00", "03:00", "04:00", "05:00", "06:00", "07:00", "08:00", "09:00", "10:00", "11:00", "12:00", "13:00", "14:00", "15:00", "16:00", "17:00", "18:00", "19:00", "20:00", "21:00", "22:00", "23:00"`
+	dbtTransformationModelIdDescription      = "The unique identifier for the dbt Model within the Fivetran system."
+	dbtTransformationOutputModelDescription  = "The dbt Model name."
+	dbtTransformationCreatedAtDescription    = "The timestamp of the dbt Transformation creation."
+	dbtTransformationConnectorIdsDescription = "Identifiers of related connectors."
+	dbtTransformationModelIdsDescription     = "Identifiers of related models."
+)
+
+func DbtTransformationResource(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: dbtTransformationIdDescription,
+			},
+			"dbt_project_id": schema.StringAttribute{
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Description:   dbtTransformationProjectIdDescription,
+			},
+			"dbt_model_name": schema.StringAttribute{
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Description:   dbtTransformationModelNameDescription,
+			},
+			"run_tests": schema.BoolAttribute{
+				Required:    true,
+				Description: dbtTransformationRunTestsDescription,
+			},
+			"paused": schema.BoolAttribute{
+				Required:    true,
+				Description: dbtTransformationPausedDescription,
+			},
+			"dbt_model_id": schema.StringAttribute{
+				Computed:    true,
+				Description: dbtTransformationModelIdDescription,
+			},
+			"output_model_name": schema.StringAttribute{
+				Computed:    true,
+				Description: dbtTransformationOutputModelDescription,
+			},
+			"created_at": schema.StringAttribute{
+				Computed:    true,
+				Description: dbtTransformationCreatedAtDescription,
+			},
+			"connector_ids": schema.SetAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: dbtTransformationConnectorIdsDescription,
+			},
+			"model_ids": schema.SetAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: dbtTransformationModelIdsDescription,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"schedule": schema.ListNestedBlock{
+				Description: dbtTransformationScheduleDescription,
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"schedule_type": schema.StringAttribute{
+							Required:    true,
+							Description: dbtTransformationScheduleTypeDescription,
+						},
+						"days_of_week": schema.SetAttribute{
+							Optional:    true,
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: dbtTransformationDaysOfWeekDescription,
+						},
+						"interval": schema.Int64Attribute{
+							Optional:    true,
+							Computed:    true,
+							Description: dbtTransformationIntervalDescription,
+						},
+						"time_of_day": schema.StringAttribute{
+							Optional:    true,
+							Computed:    true,
+							Description: dbtTransformationTimeOfDayDescription,
+						},
+					},
+				},
+			},
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+			}),
+		},
+	}
+}
+
+func DbtTransformationDatasource() datasourceSchema.Schema {
+	return datasourceSchema.Schema{
+		Attributes: map[string]datasourceSchema.Attribute{
+			"id": datasourceSchema.StringAttribute{
+				Required:    true,
+				Description: dbtTransformationIdDescription,
+			},
+			"dbt_project_id": datasourceSchema.StringAttribute{
+				Computed:    true,
+				Description: dbtTransformationProjectIdDescription,
+			},
+			"dbt_model_name": datasourceSchema.StringAttribute{
+				Computed:    true,
+				Description: dbtTransformationModelNameDescription,
+			},
+			"run_tests": datasourceSchema.BoolAttribute{
+				Computed:    true,
+				Description: dbtTransformationRunTestsDescription,
+			},
+			"paused": datasourceSchema.BoolAttribute{
+				Computed:    true,
+				Description: dbtTransformationPausedDescription,
+			},
+			"dbt_model_id": datasourceSchema.StringAttribute{
+				Computed:    true,
+				Description: dbtTransformationModelIdDescription,
+			},
+			"output_model_name": datasourceSchema.StringAttribute{
+				Computed:    true,
+				Description: dbtTransformationOutputModelDescription,
+			},
+			"created_at": datasourceSchema.StringAttribute{
+				Computed:    true,
+				Description: dbtTransformationCreatedAtDescription,
+			},
+			"connector_ids": datasourceSchema.SetAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: dbtTransformationConnectorIdsDescription,
+			},
+			"model_ids": datasourceSchema.SetAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: dbtTransformationModelIdsDescription,
+			},
+			"schedule": datasourceSchema.ListNestedAttribute{
+				Computed:    true,
+				Description: dbtTransformationScheduleDescription,
+				NestedObject: datasourceSchema.NestedAttributeObject{
+					Attributes: map[string]datasourceSchema.Attribute{
+						"schedule_type": datasourceSchema.StringAttribute{
+							Computed:    true,
+							Description: dbtTransformationScheduleTypeDescription,
+						},
+						"days_of_week": datasourceSchema.SetAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: dbtTransformationDaysOfWeekDescription,
+						},
+						"interval": datasourceSchema.Int64Attribute{
+							Computed:    true,
+							Description: dbtTransformationIntervalDescription,
+						},
+						"time_of_day": datasourceSchema.StringAttribute{
+							Computed:    true,
+							Description: dbtTransformationTimeOfDayDescription,
+						},
+					},
+				},
+			},
+		},
+	}
+}
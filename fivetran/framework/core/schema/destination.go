@@ -54,6 +54,26 @@ func DestinationAttributesSchema() core.Schema {
 				Description:  "Specifies whether the setup tests should be run automatically. The default value is TRUE.",
 				ResourceOnly: true,
 			},
+			"networking_method": {
+				ValueType:    core.String,
+				Description:  "The method used to connect to the destination. Possible values: `Directly`, `SshTunnel`, `ProxyAgent`, `PrivateLink`. The field is managed only when it is set in the configuration.",
+				ResourceOnly: true,
+			},
+			"private_link_id": {
+				ValueType:    core.String,
+				Description:  "The private link ID (e.g. `fivetran_private_link.my_link.id`) used by the destination when `networking_method` is `PrivateLink`. The field is managed only when it is set in the configuration.",
+				ResourceOnly: true,
+			},
+			"hybrid_deployment_agent_id": {
+				ValueType:    core.String,
+				Description:  "The hybrid deployment agent ID (e.g. `fivetran_hybrid_deployment_agent.my_agent.id`) that processes the data of the destination group locally. The field is managed only when it is set in the configuration.",
+				ResourceOnly: true,
+			},
+			"fail_on_setup_test_failure": {
+				ValueType:    core.Boolean,
+				Description:  "Specifies whether the apply should fail if any of the setup tests has `FAILED` or `JOB_FAILED` status. The setup tests results are available in the `setup_tests` attribute. The default value is FALSE.",
+				ResourceOnly: true,
+			},
 			"setup_status": {
 				Readonly:    true,
 				ValueType:   core.String,
@@ -63,6 +83,13 @@ func DestinationAttributesSchema() core.Schema {
 	}
 }
 
+func DestinationResourceAttributes() map[string]resourceSchema.Attribute {
+	result := DestinationAttributesSchema().GetResourceSchema()
+	result["setup_tests"] = setupTestsResourceAttribute()
+	result["config_json"] = configJsonResourceAttribute("config")
+	return result
+}
+
 func DestinationResourceBlocks(ctx context.Context) map[string]resourceSchema.Block {
 
 	config := resourceSchema.SingleNestedBlock{
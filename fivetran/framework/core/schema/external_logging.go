@@ -0,0 +1,147 @@
+package schema
+
+import (
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	datasourceSchema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+const (
+	externalLoggingIdDescription            = "The unique identifier for the log service within the Fivetran system."
+	externalLoggingGroupIdDescription       = "The unique identifier for the log service within the Fivetran system."
+	externalLoggingServiceDescription       = "The name for the log service type within the Fivetran system. We support the following log services: azure_monitor_log, cloudwatch, datadog_log, new_relic_log, splunkLog, stackdriver."
+	externalLoggingEnabledDescription       = "The boolean value specifying whether the log service is enabled."
+	externalLoggingRunSetupTestsDescription = "Specifies whether the setup tests should be run automatically. The default value is TRUE."
+)
+
+type externalLoggingConfigField struct {
+	valueType   core.FieldValueType
+	sensitive   bool
+	description string
+}
+
+var externalLoggingConfigFields = map[string]externalLoggingConfigField{
+	"workspace_id":   {valueType: core.String, description: "Workspace ID"},
+	"primary_key":    {valueType: core.String, sensitive: true, description: "Primary Key"},
+	"log_group_name": {valueType: core.String, description: "Log Group Name"},
+	"role_arn":       {valueType: core.String, description: "Role Arn"},
+	"external_id":    {valueType: core.String, description: "external_id"},
+	"region":         {valueType: core.String, description: "Region"},
+	"api_key":        {valueType: core.String, sensitive: true, description: "API Key"},
+	"sub_domain":     {valueType: core.String, description: "Sub Domain"},
+	"host":           {valueType: core.String, description: "Server name"},
+	"hostname":       {valueType: core.String, description: "Server name"},
+	"port":           {valueType: core.Integer, description: "Port"},
+	"channel":        {valueType: core.String, description: "Channel"},
+	"enable_ssl":     {valueType: core.Boolean, description: "Enable SSL"},
+	"token":          {valueType: core.String, sensitive: true, description: "Token"},
+	"project_id":     {valueType: core.String, description: "Project Id for Google Cloud Logging"},
+}
+
+func ExternalLoggingResource() schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: externalLoggingIdDescription,
+			},
+			"group_id": schema.StringAttribute{
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Description:   externalLoggingGroupIdDescription,
+			},
+			"service": schema.StringAttribute{
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Description:   externalLoggingServiceDescription,
+			},
+			"enabled": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: externalLoggingEnabledDescription,
+			},
+			"run_setup_tests": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: externalLoggingRunSetupTestsDescription,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"config": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: externalLoggingConfigResourceAttributes(),
+				},
+			},
+		},
+	}
+}
+
+func ExternalLoggingDatasource() datasourceSchema.Schema {
+	return datasourceSchema.Schema{
+		Attributes: map[string]datasourceSchema.Attribute{
+			"id": datasourceSchema.StringAttribute{
+				Required:    true,
+				Description: externalLoggingIdDescription,
+			},
+			"group_id": datasourceSchema.StringAttribute{
+				Computed:    true,
+				Description: externalLoggingGroupIdDescription,
+			},
+			"service": datasourceSchema.StringAttribute{
+				Computed:    true,
+				Description: externalLoggingServiceDescription,
+			},
+			"enabled": datasourceSchema.BoolAttribute{
+				Computed:    true,
+				Description: externalLoggingEnabledDescription,
+			},
+			"config": datasourceSchema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: datasourceSchema.NestedAttributeObject{
+					Attributes: externalLoggingConfigDatasourceAttributes(),
+				},
+			},
+		},
+	}
+}
+
+func externalLoggingConfigResourceAttributes() map[string]schema.Attribute {
+	result := map[string]schema.Attribute{}
+	for k, v := range externalLoggingConfigFields {
+		switch v.valueType {
+		case core.Integer:
+			result[k] = schema.Int64Attribute{Optional: true, Sensitive: v.sensitive, Description: v.description}
+		case core.Boolean:
+			result[k] = schema.BoolAttribute{Optional: true, Sensitive: v.sensitive, Description: v.description}
+		default:
+			result[k] = schema.StringAttribute{Optional: true, Sensitive: v.sensitive, Description: v.description}
+		}
+	}
+	return result
+}
+
+func externalLoggingConfigDatasourceAttributes() map[string]datasourceSchema.Attribute {
+	result := map[string]datasourceSchema.Attribute{}
+	for k, v := range externalLoggingConfigFields {
+		switch v.valueType {
+		case core.Integer:
+			result[k] = datasourceSchema.Int64Attribute{Computed: true, Sensitive: v.sensitive, Description: v.description}
+		case core.Boolean:
+			result[k] = datasourceSchema.BoolAttribute{Computed: true, Sensitive: v.sensitive, Description: v.description}
+		default:
+			result[k] = datasourceSchema.StringAttribute{Computed: true, Sensitive: v.sensitive, Description: v.description}
+		}
+	}
+	return result
+}
@@ -0,0 +1,27 @@
+package schema
+
+import (
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core"
+)
+
+func Group() core.Schema {
+	return core.Schema{
+		Fields: map[string]core.SchemaField{
+			"id": {
+				IsId:        true,
+				ValueType:   core.String,
+				Description: "The unique identifier for the group within the Fivetran system.",
+			},
+			"name": {
+				Required:    true,
+				ValueType:   core.String,
+				Description: "The name of the group within your account.",
+			},
+			"created_at": {
+				Readonly:    true,
+				ValueType:   core.String,
+				Description: "The timestamp of when the group was created in your account.",
+			},
+		},
+	}
+}
@@ -0,0 +1,86 @@
+package schema
+
+import (
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core"
+	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+func GroupUsers() core.Schema {
+	return core.Schema{
+		Fields: map[string]core.SchemaField{
+			"id": {
+				IsId:        true,
+				ValueType:   core.String,
+				Description: "The unique identifier for the resource.",
+			},
+			"group_id": {
+				Required:    true,
+				ForceNew:    true,
+				ValueType:   core.String,
+				Description: "The unique identifier for the Group within the Fivetran system.",
+			},
+			"authoritative": {
+				ValueType:    core.Boolean,
+				ResourceOnly: true,
+				Description:  "Specifies whether the resource manages all the users of the group. When `true`, the users of the group that are not listed in the `user` blocks are removed from the group. When `false`, only the listed users are managed and the other users of the group are kept untouched, so the group users can be managed by several resources (e.g. `fivetran_group_user`). The default value is TRUE.",
+			},
+		},
+	}
+}
+
+func GroupUser() core.Schema {
+	return core.Schema{
+		Fields: map[string]core.SchemaField{
+			"id": {
+				IsId:        true,
+				ValueType:   core.String,
+				Description: "The unique identifier for the resource. Equal to `{group_id}:{email}`.",
+			},
+			"group_id": {
+				Required:    true,
+				ForceNew:    true,
+				ValueType:   core.String,
+				Description: "The unique identifier for the Group within the Fivetran system.",
+			},
+			"email": {
+				Required:    true,
+				ForceNew:    true,
+				ValueType:   core.String,
+				Description: "The email address that the user has associated with their user profile.",
+			},
+			"role": {
+				Required:    true,
+				ValueType:   core.String,
+				Description: "The group role that you would like to assign this user to. Supported group roles: ‘Destination Administrator‘, ‘Destination Reviewer‘, ‘Destination Analyst‘, ‘Connector Creator‘, or a custom destination role",
+			},
+			"user_id": {
+				Readonly:    true,
+				ValueType:   core.String,
+				Description: "The unique identifier for the user within the account.",
+			},
+		},
+	}
+}
+
+func GroupUsersBlocks() map[string]resourceSchema.Block {
+	return map[string]resourceSchema.Block{
+		"user": resourceSchema.SetNestedBlock{
+			NestedObject: resourceSchema.NestedBlockObject{
+				Attributes: map[string]resourceSchema.Attribute{
+					"id": resourceSchema.StringAttribute{
+						Computed:    true,
+						Description: "The unique identifier for the user within the account.",
+					},
+					"email": resourceSchema.StringAttribute{
+						Required:    true,
+						Description: "The email address that the user has associated with their user profile.",
+					},
+					"role": resourceSchema.StringAttribute{
+						Required:    true,
+						Description: "The group role that you would like to assign this new user to. Supported group roles: ‘Destination Administrator‘, ‘Destination Reviewer‘, ‘Destination Analyst‘, ‘Connector Creator‘, or a custom destination role",
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,103 @@
+package schema
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+func GetHybridDeploymentAgentResourceSchema() schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The unique identifier for the hybrid deployment agent within the Fivetran system.",
+			},
+			"group_id": schema.StringAttribute{
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Description:   "The unique identifier for the group or group ID the agent belongs to.",
+			},
+			"display_name": schema.StringAttribute{
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Description:   "The unique name for the hybrid deployment agent.",
+			},
+			"env_type": schema.StringAttribute{
+				Required:      true,
+				PlanModifiers: []planmodifier.String{requiresReplaceIfKnownInState()},
+				Description:   "Environment type the agent runs in. Possible values: `DOCKER`, `PODMAN`, `KUBERNETES`, `SNOWPARK`.",
+			},
+			"auth_type": schema.StringAttribute{
+				Required:      true,
+				PlanModifiers: []planmodifier.String{requiresReplaceIfKnownInState()},
+				Description:   "Authentication type of the agent. Possible values: `AUTO`, `MANUAL`.",
+			},
+			"accept_terms": schema.BoolAttribute{
+				Required:    true,
+				Validators:  []validator.Bool{acceptTermsValidator{}},
+				Description: "Specifies whether you accept the [Fivetran Hybrid Deployment terms](https://fivetran.com/docs/deployment-models/hybrid-deployment). The value must be `true` to create the agent.",
+			},
+			"authentication_counter": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Change the value of the field (e.g. increment it) to re-authenticate the agent: new `token`, `config_file` and `auth_file` are generated and the previous ones stop working.",
+			},
+			"registered_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "The timestamp of the time the hybrid deployment agent was registered.",
+			},
+			"token": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The base64-encoded token of the agent. The value is known only after the agent is created or re-authenticated by the resource.",
+			},
+			"config_file": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The content of the agent `config.json` file. The value is known only after the agent is created or re-authenticated by the resource.",
+			},
+			"auth_file": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The content of the agent `auth.json` file. The value is known only after the agent is created or re-authenticated by the resource.",
+			},
+		},
+	}
+}
+
+// requiresReplaceIfKnownInState requires replacement only when the value was set before:
+// the field is not returned by the API, so setting it after import doesn't recreate the agent
+func requiresReplaceIfKnownInState() planmodifier.String {
+	return stringplanmodifier.RequiresReplaceIf(
+		func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+			resp.RequiresReplace = !req.StateValue.IsNull()
+		},
+		"If the value of this attribute changes, Terraform will destroy and recreate the resource.",
+		"If the value of this attribute changes, Terraform will destroy and recreate the resource.",
+	)
+}
+
+// acceptTermsValidator requires the terms to be accepted explicitly in the configuration
+type acceptTermsValidator struct{}
+
+func (v acceptTermsValidator) Description(ctx context.Context) string {
+	return "value must be true"
+}
+
+func (v acceptTermsValidator) MarkdownDescription(ctx context.Context) string {
+	return "value must be `true`"
+}
+
+func (v acceptTermsValidator) ValidateBool(ctx context.Context, req validator.BoolRequest, resp *validator.BoolResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() || req.ConfigValue.ValueBool() {
+		return
+	}
+	resp.Diagnostics.AddAttributeError(
+		req.Path,
+		"Hybrid Deployment terms are not accepted.",
+		"Set `accept_terms = true` to accept the Fivetran Hybrid Deployment terms and create the agent.",
+	)
+}
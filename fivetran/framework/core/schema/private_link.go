@@ -0,0 +1,137 @@
+package schema
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	datasourceSchema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func GetPrivateLinkResourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The unique identifier for the private link within the Fivetran system.",
+			},
+			"name": schema.StringAttribute{
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Description:   "The private link name within the account. The name must start with a letter or underscore and can only contain letters, numbers, or underscores.",
+			},
+			"region": schema.StringAttribute{
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Description:   "Data processing location. This is where Fivetran will operate and run computation on data.",
+			},
+			"service": schema.StringAttribute{
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Description:   "Service type (e.g. `SOURCE_AWS`, `REDSHIFT_AWS`, `SNOWFLAKE_AWS`).",
+			},
+			"config": schema.MapAttribute{
+				Required:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Map{
+					mapvalidator.KeysAre(stringvalidator.OneOf(
+						"connection_service_name",
+						"connection_service_id",
+						"workspace_url",
+						"account_name",
+						"account_url",
+						"vpce_id",
+						"pls_id",
+						"aws_account_id",
+						"cluster_identifier",
+						"sub_resource_name",
+					)),
+				},
+				Description: "Service-specific private link configuration (e.g. `connection_service_name`, `account_url`, `aws_account_id`). See [Private Link setup configurations](https://fivetran.com/docs/rest-api/private-links-management#privatelinksetupconfigurations) for the list of fields supported by each service.",
+			},
+			"cloud_provider": schema.StringAttribute{
+				Computed:    true,
+				Description: "The cloud provider of the private link.",
+			},
+			"state": schema.StringAttribute{
+				Computed:    true,
+				Description: "The state of the private link.",
+			},
+			"state_summary": schema.StringAttribute{
+				Computed:    true,
+				Description: "The summary of the private link state.",
+			},
+			"created_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "The timestamp of the time the private link was created.",
+			},
+			"created_by": schema.StringAttribute{
+				Computed:    true,
+				Description: "The unique identifier for the User within the Fivetran system who created the private link.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+			}),
+		},
+	}
+}
+
+func GetPrivateLinksDatasourceSchema() datasourceSchema.Schema {
+	return datasourceSchema.Schema{
+		Attributes: map[string]datasourceSchema.Attribute{
+			"private_links": datasourceSchema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The list of private links within the account.",
+				NestedObject: datasourceSchema.NestedAttributeObject{
+					Attributes: map[string]datasourceSchema.Attribute{
+						"id": datasourceSchema.StringAttribute{
+							Computed:    true,
+							Description: "The unique identifier for the private link within the Fivetran system.",
+						},
+						"name": datasourceSchema.StringAttribute{
+							Computed:    true,
+							Description: "The private link name within the account.",
+						},
+						"region": datasourceSchema.StringAttribute{
+							Computed:    true,
+							Description: "Data processing location.",
+						},
+						"service": datasourceSchema.StringAttribute{
+							Computed:    true,
+							Description: "Service type.",
+						},
+						"cloud_provider": datasourceSchema.StringAttribute{
+							Computed:    true,
+							Description: "The cloud provider of the private link.",
+						},
+						"state": datasourceSchema.StringAttribute{
+							Computed:    true,
+							Description: "The state of the private link.",
+						},
+						"state_summary": datasourceSchema.StringAttribute{
+							Computed:    true,
+							Description: "The summary of the private link state.",
+						},
+						"created_at": datasourceSchema.StringAttribute{
+							Computed:    true,
+							Description: "The timestamp of the time the private link was created.",
+						},
+						"created_by": datasourceSchema.StringAttribute{
+							Computed:    true,
+							Description: "The unique identifier for the User within the Fivetran system who created the private link.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,89 @@
+package schema
+
+import (
+	datasourceSchema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+)
+
+func GetProxyAgentResourceSchema() schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The unique identifier for the proxy agent within the Fivetran system (agent ID).",
+			},
+			"display_name": schema.StringAttribute{
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Description:   "Proxy agent name.",
+			},
+			"group_region": schema.StringAttribute{
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Description:   "Data processing location. This is where Fivetran will operate and run computation on data.",
+			},
+			"token": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The auth token of the proxy agent required to run the agent container. The token is known only after the agent is created by the resource, it is not available for imported agents.",
+			},
+			"proxy_server_uri": schema.StringAttribute{
+				Computed:    true,
+				Description: "The proxy server URI the agent connects to. The URI is known only after the agent is created by the resource.",
+			},
+			"account_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The unique identifier for the account.",
+			},
+			"created_by": schema.StringAttribute{
+				Computed:    true,
+				Description: "The actor who created the proxy agent.",
+			},
+			"registered_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "The timestamp of the time the proxy agent was registered.",
+			},
+		},
+	}
+}
+
+func GetProxyAgentsDatasourceSchema() datasourceSchema.Schema {
+	return datasourceSchema.Schema{
+		Attributes: map[string]datasourceSchema.Attribute{
+			"proxy_agents": datasourceSchema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The list of proxy agents within the account.",
+				NestedObject: datasourceSchema.NestedAttributeObject{
+					Attributes: map[string]datasourceSchema.Attribute{
+						"id": datasourceSchema.StringAttribute{
+							Computed:    true,
+							Description: "The unique identifier for the proxy agent within the Fivetran system (agent ID).",
+						},
+						"display_name": datasourceSchema.StringAttribute{
+							Computed:    true,
+							Description: "Proxy agent name.",
+						},
+						"group_region": datasourceSchema.StringAttribute{
+							Computed:    true,
+							Description: "Data processing location.",
+						},
+						"account_id": datasourceSchema.StringAttribute{
+							Computed:    true,
+							Description: "The unique identifier for the account.",
+						},
+						"created_by": datasourceSchema.StringAttribute{
+							Computed:    true,
+							Description: "The actor who created the proxy agent.",
+						},
+						"registered_at": datasourceSchema.StringAttribute{
+							Computed:    true,
+							Description: "The timestamp of the time the proxy agent was registered.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,28 @@
+package schema
+
+import (
+	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+func setupTestsResourceAttribute() resourceSchema.ListNestedAttribute {
+	return resourceSchema.ListNestedAttribute{
+		Computed:    true,
+		Description: "The results of the setup tests performed during the last create or update of the resource.",
+		NestedObject: resourceSchema.NestedAttributeObject{
+			Attributes: map[string]resourceSchema.Attribute{
+				"title": resourceSchema.StringAttribute{
+					Computed:    true,
+					Description: "Setup test title.",
+				},
+				"status": resourceSchema.StringAttribute{
+					Computed:    true,
+					Description: "The status of the setup test. The available values are: `PASSED`, `SKIPPED`, `WARNING`, `FAILED`, `JOB_FAILED`.",
+				},
+				"message": resourceSchema.StringAttribute{
+					Computed:    true,
+					Description: "Setup test message.",
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,31 @@
+package schema
+
+import (
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core"
+)
+
+func Team() core.Schema {
+	return core.Schema{
+		Fields: map[string]core.SchemaField{
+			"id": {
+				IsId:        true,
+				ValueType:   core.String,
+				Description: "The unique identifier for the team within your account.",
+			},
+			"name": {
+				Required:    true,
+				ValueType:   core.String,
+				Description: "The name of the team within your account.",
+			},
+			"description": {
+				ValueType:   core.String,
+				Description: "The description of the team within your account.",
+			},
+			"role": {
+				Required:    true,
+				ValueType:   core.String,
+				Description: "The account role of the team.",
+			},
+		},
+	}
+}
@@ -0,0 +1,114 @@
+package schema
+
+import (
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core"
+	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+func TeamMembership() core.Schema {
+	return core.Schema{
+		Fields: map[string]core.SchemaField{
+			"id": {
+				IsId:        true,
+				ValueType:   core.String,
+				Description: "The unique identifier for resource.",
+			},
+			"team_id": {
+				Required:    true,
+				ForceNew:    true,
+				ValueType:   core.String,
+				Description: "The unique identifier for the team within your account.",
+			},
+		},
+	}
+}
+
+func TeamConnectorMembershipBlocks() map[string]resourceSchema.Block {
+	return map[string]resourceSchema.Block{
+		"connector": membershipBlock("team", "connector_id", "connector", true),
+	}
+}
+
+func TeamGroupMembershipBlocks() map[string]resourceSchema.Block {
+	return map[string]resourceSchema.Block{
+		"group": membershipBlock("team", "group_id", "group", true),
+	}
+}
+
+func TeamUserMembershipBlocks() map[string]resourceSchema.Block {
+	return map[string]resourceSchema.Block{
+		"user": membershipBlock("team", "user_id", "user", false),
+	}
+}
+
+func membershipBlock(owner, idField, entity string, withCreatedAt bool) resourceSchema.SetNestedBlock {
+	attributes := map[string]resourceSchema.Attribute{
+		idField: resourceSchema.StringAttribute{
+			Required:    true,
+			Description: "The " + entity + " unique identifier",
+		},
+		"role": resourceSchema.StringAttribute{
+			Required:    true,
+			Description: "The " + owner + "'s role that links the " + owner + " and the " + entity,
+		},
+	}
+	if withCreatedAt {
+		attributes["created_at"] = resourceSchema.StringAttribute{
+			Computed:    true,
+			Description: "The date and time the membership was created",
+		}
+	}
+	return resourceSchema.SetNestedBlock{
+		NestedObject: resourceSchema.NestedBlockObject{
+			Attributes: attributes,
+		},
+	}
+}
+
+func TeamUser() core.Schema {
+	return teamMemberSchema("user_id", "user", false)
+}
+
+func TeamGroupAccess() core.Schema {
+	return teamMemberSchema("group_id", "group", true)
+}
+
+func TeamConnectorAccess() core.Schema {
+	return teamMemberSchema("connector_id", "connector", true)
+}
+
+// teamMemberSchema describes a single membership of the team, unlike the membership blocks it doesn't own all the memberships of the team
+func teamMemberSchema(idField, entity string, withCreatedAt bool) core.Schema {
+	fields := map[string]core.SchemaField{
+		"id": {
+			IsId:        true,
+			ValueType:   core.String,
+			Description: "The unique identifier for resource. Equal to `{team_id}:{" + idField + "}`.",
+		},
+		"team_id": {
+			Required:    true,
+			ForceNew:    true,
+			ValueType:   core.String,
+			Description: "The unique identifier for the team within your account.",
+		},
+		idField: {
+			Required:    true,
+			ForceNew:    true,
+			ValueType:   core.String,
+			Description: "The " + entity + " unique identifier",
+		},
+		"role": {
+			Required:    true,
+			ValueType:   core.String,
+			Description: "The team's role that links the team and the " + entity,
+		},
+	}
+	if withCreatedAt {
+		fields["created_at"] = core.SchemaField{
+			Readonly:    true,
+			ValueType:   core.String,
+			Description: "The date and time the membership was created",
+		}
+	}
+	return core.Schema{Fields: fields}
+}
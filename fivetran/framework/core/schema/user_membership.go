@@ -0,0 +1,80 @@
+package schema
+
+import (
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core"
+	datasourceSchema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+func UserMembership() core.Schema {
+	return core.Schema{
+		Fields: map[string]core.SchemaField{
+			"id": {
+				IsId:        true,
+				ValueType:   core.String,
+				Description: "The unique identifier for resource.",
+			},
+			"user_id": {
+				Required:    true,
+				ForceNew:    true,
+				ValueType:   core.String,
+				Description: "The unique identifier for the user within your account.",
+			},
+		},
+	}
+}
+
+func UserConnectorMembershipBlocks() map[string]resourceSchema.Block {
+	return map[string]resourceSchema.Block{
+		"connector": membershipBlock("user", "connector_id", "connector", true),
+	}
+}
+
+func UserGroupMembershipBlocks() map[string]resourceSchema.Block {
+	return map[string]resourceSchema.Block{
+		"group": membershipBlock("user", "group_id", "group", true),
+	}
+}
+
+func GetUserConnectorMembershipsDatasourceSchema() datasourceSchema.Schema {
+	return userMembershipsDatasourceSchema("connector_id", "connector")
+}
+
+func GetUserGroupMembershipsDatasourceSchema() datasourceSchema.Schema {
+	return userMembershipsDatasourceSchema("group_id", "group")
+}
+
+func userMembershipsDatasourceSchema(idField, entity string) datasourceSchema.Schema {
+	return datasourceSchema.Schema{
+		Attributes: map[string]datasourceSchema.Attribute{
+			"id": datasourceSchema.StringAttribute{
+				Computed:    true,
+				Description: "The unique identifier for resource.",
+			},
+			"user_id": datasourceSchema.StringAttribute{
+				Required:    true,
+				Description: "The unique identifier for the user within your account.",
+			},
+			entity: datasourceSchema.SetNestedAttribute{
+				Computed:    true,
+				Description: "The " + entity + " memberships of the user.",
+				NestedObject: datasourceSchema.NestedAttributeObject{
+					Attributes: map[string]datasourceSchema.Attribute{
+						idField: datasourceSchema.StringAttribute{
+							Computed:    true,
+							Description: "The " + entity + " unique identifier",
+						},
+						"role": datasourceSchema.StringAttribute{
+							Computed:    true,
+							Description: "The user's role that links the user and the " + entity,
+						},
+						"created_at": datasourceSchema.StringAttribute{
+							Computed:    true,
+							Description: "The date and time the membership was created",
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,87 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/fivetran/go-fivetran"
+	"github.com/fivetran/go-fivetran/dbt"
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core"
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core/model"
+	fivetranSchema "github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core/schema"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+)
+
+func DbtModels() datasource.DataSource {
+	return &dbtModels{}
+}
+
+// Ensure the implementation satisfies the desired interfaces.
+var _ datasource.DataSourceWithConfigure = &dbtModels{}
+
+type dbtModels struct {
+	core.ProviderDatasource
+}
+
+func (d *dbtModels) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = "fivetran_dbt_models"
+}
+
+func (d *dbtModels) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = fivetranSchema.DbtModelsDatasource()
+}
+
+func (d *dbtModels) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if d.GetClient() == nil {
+		resp.Diagnostics.AddError(
+			"Unconfigured Fivetran Client",
+			"Please report this issue to the provider developers.",
+		)
+
+		return
+	}
+
+	var data model.DbtModels
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	modelsResponse, err := dbtModelsForProject(ctx, d.GetClient(), data.ProjectId.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Read error.",
+			fmt.Sprintf("%v; code: %v; message: %v", err, modelsResponse.Code, modelsResponse.Message),
+		)
+		return
+	}
+
+	data.ReadFromResponse(modelsResponse)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// dbtModelsForProject returns all models of the dbt project, it handles limits and cursors
+func dbtModelsForProject(ctx context.Context, client *fivetran.Client, projectId string) (dbt.DbtModelsListResponse, error) {
+	var result dbt.DbtModelsListResponse
+	var cursor string
+
+	for {
+		svc := client.NewDbtModelsList().ProjectId(projectId).Limit(1000)
+		if cursor != "" {
+			svc.Cursor(cursor)
+		}
+		resp, err := svc.Do(ctx)
+		if err != nil {
+			return resp, err
+		}
+
+		result.Data.Items = append(result.Data.Items, resp.Data.Items...)
+
+		if resp.Data.NextCursor == "" {
+			break
+		}
+		cursor = resp.Data.NextCursor
+	}
+
+	return result, nil
+}
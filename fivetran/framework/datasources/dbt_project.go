@@ -0,0 +1,72 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core"
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core/model"
+	fivetranSchema "github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core/schema"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+)
+
+func DbtProject() datasource.DataSource {
+	return &dbtProject{}
+}
+
+// Ensure the implementation satisfies the desired interfaces.
+var _ datasource.DataSourceWithConfigure = &dbtProject{}
+
+type dbtProject struct {
+	core.ProviderDatasource
+}
+
+func (d *dbtProject) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = "fivetran_dbt_project"
+}
+
+func (d *dbtProject) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = fivetranSchema.DbtProjectDatasource()
+}
+
+func (d *dbtProject) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if d.GetClient() == nil {
+		resp.Diagnostics.AddError(
+			"Unconfigured Fivetran Client",
+			"Please report this issue to the provider developers.",
+		)
+
+		return
+	}
+
+	var data model.DbtProjectDatasourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	projectResponse, err := d.GetClient().NewDbtProjectDetails().DbtProjectID(data.Id.ValueString()).Do(ctx)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Read error.",
+			fmt.Sprintf("%v; code: %v; message: %v", err, projectResponse.Code, projectResponse.Message),
+		)
+		return
+	}
+
+	data.ReadFromResponse(projectResponse)
+
+	if strings.ToLower(projectResponse.Data.Status) == "ready" {
+		modelsResponse, err := dbtModelsForProject(ctx, d.GetClient(), projectResponse.Data.ID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Read error.",
+				fmt.Sprintf("%v; code: %v; message: %v", err, modelsResponse.Code, modelsResponse.Message),
+			)
+			return
+		}
+		data.ReadModelsFromResponse(modelsResponse)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
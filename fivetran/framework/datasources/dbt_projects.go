@@ -0,0 +1,87 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/fivetran/go-fivetran"
+	"github.com/fivetran/go-fivetran/dbt"
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core"
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core/model"
+	fivetranSchema "github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core/schema"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+)
+
+func DbtProjects() datasource.DataSource {
+	return &dbtProjects{}
+}
+
+// Ensure the implementation satisfies the desired interfaces.
+var _ datasource.DataSourceWithConfigure = &dbtProjects{}
+
+type dbtProjects struct {
+	core.ProviderDatasource
+}
+
+func (d *dbtProjects) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = "fivetran_dbt_projects"
+}
+
+func (d *dbtProjects) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = fivetranSchema.DbtProjectsDatasource()
+}
+
+func (d *dbtProjects) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if d.GetClient() == nil {
+		resp.Diagnostics.AddError(
+			"Unconfigured Fivetran Client",
+			"Please report this issue to the provider developers.",
+		)
+
+		return
+	}
+
+	var data model.DbtProjects
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	projectsResponse, err := allDbtProjects(ctx, d.GetClient())
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Read error.",
+			fmt.Sprintf("%v; code: %v; message: %v", err, projectsResponse.Code, projectsResponse.Message),
+		)
+		return
+	}
+
+	data.ReadFromResponse(projectsResponse)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// allDbtProjects returns all dbt projects of the account, it handles limits and cursors
+func allDbtProjects(ctx context.Context, client *fivetran.Client) (dbt.DbtProjectsListResponse, error) {
+	var result dbt.DbtProjectsListResponse
+	var cursor string
+
+	for {
+		svc := client.NewDbtProjectsList().Limit(1000)
+		if cursor != "" {
+			svc.Cursor(cursor)
+		}
+		resp, err := svc.Do(ctx)
+		if err != nil {
+			return resp, err
+		}
+
+		result.Data.Items = append(result.Data.Items, resp.Data.Items...)
+
+		if resp.Data.NextCursor == "" {
+			break
+		}
+		cursor = resp.Data.NextCursor
+	}
+
+	return result, nil
+}
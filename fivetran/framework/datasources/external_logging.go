@@ -0,0 +1,59 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core"
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core/model"
+	fivetranSchema "github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core/schema"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+)
+
+func ExternalLogging() datasource.DataSource {
+	return &externalLogging{}
+}
+
+// Ensure the implementation satisfies the desired interfaces.
+var _ datasource.DataSourceWithConfigure = &externalLogging{}
+
+type externalLogging struct {
+	core.ProviderDatasource
+}
+
+func (d *externalLogging) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = "fivetran_external_logging"
+}
+
+func (d *externalLogging) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = fivetranSchema.ExternalLoggingDatasource()
+}
+
+func (d *externalLogging) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if d.GetClient() == nil {
+		resp.Diagnostics.AddError(
+			"Unconfigured Fivetran Client",
+			"Please report this issue to the provider developers.",
+		)
+
+		return
+	}
+
+	var data model.ExternalLoggingDatasourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	externalLoggingResponse, err := d.GetClient().NewExternalLoggingDetails().ExternalLoggingId(data.Id.ValueString()).Do(ctx)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Read error.",
+			fmt.Sprintf("%v; code: %v; message: %v", err, externalLoggingResponse.Code, externalLoggingResponse.Message),
+		)
+		return
+	}
+
+	data.ReadFromResponse(externalLoggingResponse)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
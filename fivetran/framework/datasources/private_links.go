@@ -0,0 +1,72 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+
+	privatelinks "github.com/fivetran/go-fivetran/private_links"
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core"
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core/model"
+	fivetranSchema "github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core/schema"
+	"github.com/fivetran/terraform-provider-fivetran/modules/fivetranapi"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+)
+
+func PrivateLinks() datasource.DataSource {
+	return &privateLinks{}
+}
+
+// Ensure the implementation satisfies the desired interfaces.
+var _ datasource.DataSourceWithConfigure = &privateLinks{}
+
+type privateLinks struct {
+	core.ProviderDatasource
+}
+
+func (d *privateLinks) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = "fivetran_private_links"
+}
+
+func (d *privateLinks) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = fivetranSchema.GetPrivateLinksDatasourceSchema()
+}
+
+func (d *privateLinks) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if d.GetClient() == nil {
+		resp.Diagnostics.AddError(
+			"Unconfigured Fivetran Client",
+			"Please report this issue to the provider developers.",
+		)
+
+		return
+	}
+
+	var data model.PrivateLinks
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	var items []privatelinks.PrivateLinksResponseBase
+	var cursor string
+	limit := 1000
+
+	for {
+		listResponse, err := fivetranapi.ListPrivateLinks(ctx, d.GetClient(), limit, cursor)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Read error.",
+				fmt.Sprintf("%v; code: %v; message: %v", err, listResponse.Code, listResponse.Message),
+			)
+			return
+		}
+
+		items = append(items, listResponse.Data.Items...)
+
+		if listResponse.Data.NextCursor == "" {
+			break
+		}
+		cursor = listResponse.Data.NextCursor
+	}
+
+	data.ReadFromResponse(items)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
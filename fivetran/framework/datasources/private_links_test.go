@@ -0,0 +1,96 @@
+package datasources_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/fivetran/go-fivetran/tests/mock"
+	tfmock "github.com/fivetran/terraform-provider-fivetran/fivetran/tests/mock"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestDataSourcePrivateLinksMappingMock(t *testing.T) {
+	var listHandler *mock.Handler
+
+	step1 := resource.TestStep{
+		Config: `
+		data "fivetran_private_links" "test_data" {
+			provider = fivetran-provider
+		}`,
+
+		Check: resource.ComposeAggregateTestCheckFunc(
+			func(s *terraform.State) error {
+				tfmock.AssertNotEmpty(t, listHandler.Interactions)
+				return nil
+			},
+			resource.TestCheckResourceAttr("data.fivetran_private_links.test_data", "private_links.#", "2"),
+			resource.TestCheckResourceAttr("data.fivetran_private_links.test_data", "private_links.0.id", "private_link_id_1"),
+			resource.TestCheckResourceAttr("data.fivetran_private_links.test_data", "private_links.0.service", "SOURCE_AWS"),
+			resource.TestCheckResourceAttr("data.fivetran_private_links.test_data", "private_links.1.id", "private_link_id_2"),
+			resource.TestCheckResourceAttr("data.fivetran_private_links.test_data", "private_links.1.state", "AVAILABLE"),
+		),
+	}
+
+	resource.Test(
+		t,
+		resource.TestCase{
+			PreCheck: func() {
+				tfmock.MockClient().Reset()
+
+				listHandler = tfmock.MockClient().When(http.MethodGet, "/v1/private-links").ThenCall(
+					func(req *http.Request) (*http.Response, error) {
+						var responseData map[string]interface{}
+						if req.URL.Query().Get("cursor") == "" {
+							responseData = tfmock.CreateMapFromJsonString(t, `
+							{
+								"items": [
+									{
+										"id": "private_link_id_1",
+										"name": "private_link_1",
+										"region": "AWS_US_EAST_1",
+										"service": "SOURCE_AWS",
+										"cloud_provider": "AWS",
+										"state": "PENDING",
+										"state_summary": "",
+										"created_at": "2024-01-01T00:00:00Z",
+										"created_by": "user_id"
+									}
+								],
+								"next_cursor": "next_cursor"
+							}
+							`)
+						} else {
+							responseData = tfmock.CreateMapFromJsonString(t, `
+							{
+								"items": [
+									{
+										"id": "private_link_id_2",
+										"name": "private_link_2",
+										"region": "AWS_US_EAST_1",
+										"service": "SNOWFLAKE_AWS",
+										"cloud_provider": "AWS",
+										"state": "AVAILABLE",
+										"state_summary": "",
+										"created_at": "2024-01-01T00:00:00Z",
+										"created_by": "user_id"
+									}
+								],
+								"next_cursor": null
+							}
+							`)
+						}
+						return tfmock.FivetranSuccessResponse(t, req, http.StatusOK, "Success", responseData), nil
+					},
+				)
+			},
+			ProtoV6ProviderFactories: tfmock.ProtoV6ProviderFactories,
+			CheckDestroy: func(s *terraform.State) error {
+				return nil
+			},
+			Steps: []resource.TestStep{
+				step1,
+			},
+		},
+	)
+}
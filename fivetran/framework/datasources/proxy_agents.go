@@ -0,0 +1,71 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core"
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core/model"
+	fivetranSchema "github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core/schema"
+	"github.com/fivetran/terraform-provider-fivetran/modules/fivetranapi"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+)
+
+func ProxyAgents() datasource.DataSource {
+	return &proxyAgents{}
+}
+
+// Ensure the implementation satisfies the desired interfaces.
+var _ datasource.DataSourceWithConfigure = &proxyAgents{}
+
+type proxyAgents struct {
+	core.ProviderDatasource
+}
+
+func (d *proxyAgents) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = "fivetran_proxy_agents"
+}
+
+func (d *proxyAgents) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = fivetranSchema.GetProxyAgentsDatasourceSchema()
+}
+
+func (d *proxyAgents) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if d.GetClient() == nil {
+		resp.Diagnostics.AddError(
+			"Unconfigured Fivetran Client",
+			"Please report this issue to the provider developers.",
+		)
+
+		return
+	}
+
+	var data model.ProxyAgents
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	var items []fivetranapi.ProxyAgentDetails
+	var cursor string
+	limit := 1000
+
+	for {
+		listResponse, err := fivetranapi.ListProxyAgents(ctx, d.GetClient(), limit, cursor)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Read error.",
+				fmt.Sprintf("%v; code: %v; message: %v", err, listResponse.Code, listResponse.Message),
+			)
+			return
+		}
+
+		items = append(items, listResponse.Data.Items...)
+
+		if listResponse.Data.NextCursor == "" {
+			break
+		}
+		cursor = listResponse.Data.NextCursor
+	}
+
+	data.ReadFromResponse(items)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,91 @@
+package datasources_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/fivetran/go-fivetran/tests/mock"
+	tfmock "github.com/fivetran/terraform-provider-fivetran/fivetran/tests/mock"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestDataSourceProxyAgentsMappingMock(t *testing.T) {
+	var listHandler *mock.Handler
+
+	step1 := resource.TestStep{
+		Config: `
+		data "fivetran_proxy_agents" "test_data" {
+			provider = fivetran-provider
+		}`,
+
+		Check: resource.ComposeAggregateTestCheckFunc(
+			func(s *terraform.State) error {
+				tfmock.AssertNotEmpty(t, listHandler.Interactions)
+				return nil
+			},
+			resource.TestCheckResourceAttr("data.fivetran_proxy_agents.test_data", "proxy_agents.#", "2"),
+			resource.TestCheckResourceAttr("data.fivetran_proxy_agents.test_data", "proxy_agents.0.id", "proxy_agent_id_1"),
+			resource.TestCheckResourceAttr("data.fivetran_proxy_agents.test_data", "proxy_agents.0.group_region", "GCP_US_EAST4"),
+			resource.TestCheckResourceAttr("data.fivetran_proxy_agents.test_data", "proxy_agents.0.registered_at", "2024-01-01T00:00:00Z"),
+			resource.TestCheckResourceAttr("data.fivetran_proxy_agents.test_data", "proxy_agents.1.id", "proxy_agent_id_2"),
+			resource.TestCheckResourceAttr("data.fivetran_proxy_agents.test_data", "proxy_agents.1.display_name", "proxy_agent_2"),
+		),
+	}
+
+	resource.Test(
+		t,
+		resource.TestCase{
+			PreCheck: func() {
+				tfmock.MockClient().Reset()
+
+				listHandler = tfmock.MockClient().When(http.MethodGet, "/v1/proxy").ThenCall(
+					func(req *http.Request) (*http.Response, error) {
+						var responseData map[string]interface{}
+						if req.URL.Query().Get("cursor") == "" {
+							responseData = tfmock.CreateMapFromJsonString(t, `
+							{
+								"items": [
+									{
+										"id": "proxy_agent_id_1",
+										"account_id": "account_id",
+										"registred_at": "2024-01-01T00:00:00Z",
+										"region": "GCP_US_EAST4",
+										"created_by": "user_id",
+										"display_name": "proxy_agent_1"
+									}
+								],
+								"next_cursor": "next_cursor"
+							}
+							`)
+						} else {
+							responseData = tfmock.CreateMapFromJsonString(t, `
+							{
+								"items": [
+									{
+										"id": "proxy_agent_id_2",
+										"account_id": "account_id",
+										"registred_at": "2024-01-02T00:00:00Z",
+										"region": "AWS_US_EAST_1",
+										"created_by": "user_id",
+										"display_name": "proxy_agent_2"
+									}
+								],
+								"next_cursor": null
+							}
+							`)
+						}
+						return tfmock.FivetranSuccessResponse(t, req, http.StatusOK, "Success", responseData), nil
+					},
+				)
+			},
+			ProtoV6ProviderFactories: tfmock.ProtoV6ProviderFactories,
+			CheckDestroy: func(s *terraform.State) error {
+				return nil
+			},
+			Steps: []resource.TestStep{
+				step1,
+			},
+		},
+	)
+}
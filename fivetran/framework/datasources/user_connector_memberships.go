@@ -0,0 +1,59 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core"
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core/model"
+	fivetranSchema "github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core/schema"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+)
+
+func UserConnectorMemberships() datasource.DataSource {
+	return &userConnectorMemberships{}
+}
+
+// Ensure the implementation satisfies the desired interfaces.
+var _ datasource.DataSourceWithConfigure = &userConnectorMemberships{}
+
+type userConnectorMemberships struct {
+	core.ProviderDatasource
+}
+
+func (d *userConnectorMemberships) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = "fivetran_user_connector_memberships"
+}
+
+func (d *userConnectorMemberships) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = fivetranSchema.GetUserConnectorMembershipsDatasourceSchema()
+}
+
+func (d *userConnectorMemberships) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if d.GetClient() == nil {
+		resp.Diagnostics.AddError(
+			"Unconfigured Fivetran Client",
+			"Please report this issue to the provider developers.",
+		)
+
+		return
+	}
+
+	var data model.UserConnectorMembership
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	userId := data.UserId.ValueString()
+
+	items, code, err := core.UserConnectorMemberships.List(ctx, d.GetClient(), userId)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Read error.",
+			fmt.Sprintf("%v; code: %v", err, code),
+		)
+		return
+	}
+
+	data.ReadFromResponse(userId, items)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,59 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core"
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core/model"
+	fivetranSchema "github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core/schema"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+)
+
+func UserGroupMemberships() datasource.DataSource {
+	return &userGroupMemberships{}
+}
+
+// Ensure the implementation satisfies the desired interfaces.
+var _ datasource.DataSourceWithConfigure = &userGroupMemberships{}
+
+type userGroupMemberships struct {
+	core.ProviderDatasource
+}
+
+func (d *userGroupMemberships) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = "fivetran_user_group_memberships"
+}
+
+func (d *userGroupMemberships) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = fivetranSchema.GetUserGroupMembershipsDatasourceSchema()
+}
+
+func (d *userGroupMemberships) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if d.GetClient() == nil {
+		resp.Diagnostics.AddError(
+			"Unconfigured Fivetran Client",
+			"Please report this issue to the provider developers.",
+		)
+
+		return
+	}
+
+	var data model.UserGroupMembership
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	userId := data.UserId.ValueString()
+
+	items, code, err := core.UserGroupMemberships.List(ctx, d.GetClient(), userId)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Read error.",
+			fmt.Sprintf("%v; code: %v", err, code),
+		)
+		return
+	}
+
+	data.ReadFromResponse(userId, items)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
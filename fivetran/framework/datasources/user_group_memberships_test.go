@@ -0,0 +1,92 @@
+package datasources_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/fivetran/go-fivetran/tests/mock"
+	tfmock "github.com/fivetran/terraform-provider-fivetran/fivetran/tests/mock"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestDataSourceUserGroupMembershipsMappingMock(t *testing.T) {
+	var listHandler *mock.Handler
+
+	step1 := resource.TestStep{
+		Config: `
+		data "fivetran_user_group_memberships" "test_data" {
+			provider = fivetran-provider
+
+			user_id = "user_id"
+		}`,
+
+		Check: resource.ComposeAggregateTestCheckFunc(
+			func(s *terraform.State) error {
+				tfmock.AssertNotEmpty(t, listHandler.Interactions)
+				return nil
+			},
+			resource.TestCheckResourceAttr("data.fivetran_user_group_memberships.test_data", "id", "user_id"),
+			resource.TestCheckResourceAttr("data.fivetran_user_group_memberships.test_data", "group.#", "2"),
+			resource.TestCheckTypeSetElemNestedAttrs("data.fivetran_user_group_memberships.test_data", "group.*", map[string]string{
+				"group_id":   "group_id_1",
+				"role":       "Destination Analyst",
+				"created_at": "2024-01-01T00:00:00Z",
+			}),
+			resource.TestCheckTypeSetElemNestedAttrs("data.fivetran_user_group_memberships.test_data", "group.*", map[string]string{
+				"group_id": "group_id_2",
+				"role":     "Destination Administrator",
+			}),
+		),
+	}
+
+	resource.Test(
+		t,
+		resource.TestCase{
+			PreCheck: func() {
+				tfmock.MockClient().Reset()
+
+				listHandler = tfmock.MockClient().When(http.MethodGet, "/v1/users/user_id/groups").ThenCall(
+					func(req *http.Request) (*http.Response, error) {
+						var responseData map[string]interface{}
+						if req.URL.Query().Get("cursor") == "" {
+							responseData = tfmock.CreateMapFromJsonString(t, `
+							{
+								"items": [
+									{
+										"id": "group_id_1",
+										"role": "Destination Analyst",
+										"created_at": "2024-01-01T00:00:00Z"
+									}
+								],
+								"next_cursor": "next_cursor"
+							}
+							`)
+						} else {
+							responseData = tfmock.CreateMapFromJsonString(t, `
+							{
+								"items": [
+									{
+										"id": "group_id_2",
+										"role": "Destination Administrator",
+										"created_at": "2024-01-02T00:00:00Z"
+									}
+								],
+								"next_cursor": null
+							}
+							`)
+						}
+						return tfmock.FivetranSuccessResponse(t, req, http.StatusOK, "Success", responseData), nil
+					},
+				)
+			},
+			ProtoV6ProviderFactories: tfmock.ProtoV6ProviderFactories,
+			CheckDestroy: func(s *terraform.State) error {
+				return nil
+			},
+			Steps: []resource.TestStep{
+				step1,
+			},
+		},
+	)
+}
@@ -2,6 +2,9 @@ package framework
 
 import (
 	"context"
+	"fmt"
+	"net/http"
+	"strings"
 
 	"os"
 
@@ -10,10 +13,16 @@ import (
 	"github.com/fivetran/terraform-provider-fivetran/fivetran/common"
 	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/datasources"
 	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/resources"
+	"github.com/fivetran/terraform-provider-fivetran/modules/httpclient"
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -21,19 +30,32 @@ const Version = "1.1.12" // Current provider version
 
 type fivetranProvider struct {
 	mockClient httputils.HttpClient
+
+	// error of merging field definitions set with FIVETRAN_FIELD_DEFINITIONS_PATH environment variable
+	fieldDefinitionsErr error
 }
 
+var _ provider.ProviderWithValidateConfig = &fivetranProvider{}
+
 type fivetranProviderModel struct {
-	ApiKey    types.String `tfsdk:"api_key"`
-	ApiSecret types.String `tfsdk:"api_secret"`
-	ApiUrl    types.String `tfsdk:"api_url"`
+	ApiKey       types.String `tfsdk:"api_key"`
+	ApiSecret    types.String `tfsdk:"api_secret"`
+	ApiUrl       types.String `tfsdk:"api_url"`
+	MaxRetries   types.Int64  `tfsdk:"max_retries"`
+	RetryMinWait types.String `tfsdk:"retry_min_wait"`
+	RetryMaxWait types.String `tfsdk:"retry_max_wait"`
+
+	RequestsPerSecond     types.Float64 `tfsdk:"requests_per_second"`
+	MaxConcurrentRequests types.Int64   `tfsdk:"max_concurrent_requests"`
+
+	FieldDefinitionsPath types.String `tfsdk:"field_definitions_path"`
 }
 
 func FivetranProvider() provider.Provider {
 	common.LoadConfigFieldsMap()
 	common.LoadAuthFieldsMap()
 	common.LocaDestinationFieldsMap()
-	return &fivetranProvider{mockClient: nil}
+	return &fivetranProvider{mockClient: nil, fieldDefinitionsErr: common.LoadFieldDefinitionsFromEnv()}
 }
 
 // For mocked tests
@@ -41,7 +63,7 @@ func FivetranProviderMock(client httputils.HttpClient) provider.Provider {
 	common.LoadConfigFieldsMap()
 	common.LoadAuthFieldsMap()
 	common.LocaDestinationFieldsMap()
-	return &fivetranProvider{mockClient: client}
+	return &fivetranProvider{mockClient: client, fieldDefinitionsErr: common.LoadFieldDefinitionsFromEnv()}
 }
 
 func (p *fivetranProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -54,10 +76,38 @@ func (p *fivetranProvider) Schema(ctx context.Context, req provider.SchemaReques
 			"api_key":    schema.StringAttribute{Optional: true},
 			"api_secret": schema.StringAttribute{Optional: true, Sensitive: true},
 			"api_url":    schema.StringAttribute{Optional: true},
+			"max_retries": schema.Int64Attribute{
+				Optional:   true,
+				Validators: []validator.Int64{int64validator.AtLeast(0)},
+			},
+			"retry_min_wait": schema.StringAttribute{Optional: true},
+			"retry_max_wait": schema.StringAttribute{Optional: true},
+			"requests_per_second": schema.Float64Attribute{
+				Optional:   true,
+				Validators: []validator.Float64{float64validator.AtLeast(0)},
+			},
+			"max_concurrent_requests": schema.Int64Attribute{
+				Optional:   true,
+				Validators: []validator.Int64{int64validator.AtLeast(0)},
+			},
+			"field_definitions_path": schema.StringAttribute{Optional: true},
 		},
 	}
 }
 
+// ValidateConfig merges field definitions before resources are validated: provider is not configured on validation
+func (p *fivetranProvider) ValidateConfig(ctx context.Context, req provider.ValidateConfigRequest, resp *provider.ValidateConfigResponse) {
+	var data fivetranProviderModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	p.mergeFieldDefinitions(data.FieldDefinitionsPath, &resp.Diagnostics)
+}
+
 func (p *fivetranProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
 	// Check environment variables
 	apiKey := os.Getenv("FIVETRAN_APIKEY")
@@ -79,22 +129,80 @@ func (p *fivetranProvider) Configure(ctx context.Context, req provider.Configure
 		apiUrl = data.ApiUrl.ValueString()
 	}
 
+	maxRetries := httpclient.DefaultMaxRetries
+	if !data.MaxRetries.IsNull() && !data.MaxRetries.IsUnknown() {
+		maxRetries = int(data.MaxRetries.ValueInt64())
+	}
+
+	p.mergeFieldDefinitions(data.FieldDefinitionsPath, &resp.Diagnostics)
+
+	retryMinWait, err := httpclient.ParseWait(data.RetryMinWait.ValueString(), httpclient.DefaultRetryMinWait)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("retry_min_wait"), "Invalid Retry Wait Duration", err.Error())
+	}
+
+	retryMaxWait, err := httpclient.ParseWait(data.RetryMaxWait.ValueString(), httpclient.DefaultRetryMaxWait)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("retry_max_wait"), "Invalid Retry Wait Duration", err.Error())
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Init client
 	fivetranClient := fivetran.New(apiKey, apiSecret)
 	if apiUrl != "" {
 		fivetranClient.BaseURL(apiUrl)
 	}
 
+	var httpClient httputils.HttpClient = &http.Client{}
+
 	// Set mocked http client for tests
 	if p.mockClient != nil {
-		fivetranClient.SetHttpClient(p.mockClient)
+		httpClient = p.mockClient
+	}
+
+	requestsPerSecond := data.RequestsPerSecond.ValueFloat64()
+	maxConcurrentRequests := int(data.MaxConcurrentRequests.ValueInt64())
+	if requestsPerSecond > 0 || maxConcurrentRequests > 0 {
+		// The limiter is shared with SDK provider to limit all the requests of the plugin
+		httpClient = httpclient.NewRateLimitClient(httpClient, httpclient.SharedRateLimiter(requestsPerSecond, maxConcurrentRequests))
 	}
 
+	// Rate limits are handled by retry client along with server errors
+	fivetranClient.SetHttpClient(httpclient.NewRetryClient(httpClient, maxRetries, retryMinWait, retryMaxWait))
+	fivetranClient.SetHandleRateLimits(false)
+
 	fivetranClient.CustomUserAgent("terraform-provider-fivetran/" + Version)
 	resp.DataSourceData = fivetranClient
 	resp.ResourceData = fivetranClient
 }
 
+// mergeFieldDefinitions reports the result of merging FIVETRAN_FIELD_DEFINITIONS_PATH file before the provider schema is built
+// and merges `field_definitions_path` file over the embedded definitions. The provider schema is already reported to Terraform
+// at this point, so new fields and type changes from `field_definitions_path` are skipped with a warning.
+func (p *fivetranProvider) mergeFieldDefinitions(fieldDefinitionsPath types.String, diags *diag.Diagnostics) {
+	if p.fieldDefinitionsErr != nil {
+		diags.AddError("Invalid Field Definitions",
+			fmt.Sprintf("Unable to merge field definitions from FIVETRAN_FIELD_DEFINITIONS_PATH: %v", p.fieldDefinitionsErr))
+	}
+	if fieldDefinitionsPath.IsNull() || fieldDefinitionsPath.IsUnknown() || fieldDefinitionsPath.ValueString() == "" {
+		return
+	}
+	skipped, err := common.MergeFieldDefinitionsFile(fieldDefinitionsPath.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("field_definitions_path"), "Invalid Field Definitions", err.Error())
+		return
+	}
+	if len(skipped) > 0 {
+		diags.AddAttributeWarning(path.Root("field_definitions_path"), "Field Definitions Partially Applied",
+			fmt.Sprintf("Definitions of fields %v add new fields or change field types, they can't be applied after the provider schema is loaded. "+
+				"Set the file path in FIVETRAN_FIELD_DEFINITIONS_PATH environment variable to apply them or use `config_json` and `auth_json` attributes to set these fields.",
+				strings.Join(skipped, ", ")))
+	}
+}
+
 func (p *fivetranProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		resources.User,
@@ -102,7 +210,27 @@ func (p *fivetranProvider) Resources(ctx context.Context) []func() resource.Reso
 		resources.Connector,
 		resources.ConnectorSchema,
 		resources.ConnectorSchedule,
+		resources.ConnectorSync,
+		resources.ConnectCard,
+		resources.PrivateLink,
+		resources.ProxyAgent,
+		resources.HybridDeploymentAgent,
 		resources.Destination,
+		resources.Group,
+		resources.GroupUsers,
+		resources.GroupUser,
+		resources.Team,
+		resources.TeamConnectorMembership,
+		resources.TeamGroupMembership,
+		resources.TeamUserMembership,
+		resources.TeamUser,
+		resources.TeamGroupAccess,
+		resources.TeamConnectorAccess,
+		resources.UserConnectorMembership,
+		resources.UserGroupMembership,
+		resources.DbtProject,
+		resources.DbtTransformation,
+		resources.ExternalLogging,
 	}
 }
 
@@ -115,5 +243,14 @@ func (p *fivetranProvider) DataSources(ctx context.Context) []func() datasource.
 		datasources.GroupServiceAccount,
 		datasources.Connector,
 		datasources.Destination,
+		datasources.PrivateLinks,
+		datasources.ProxyAgents,
+		datasources.UserConnectorMemberships,
+		datasources.UserGroupMemberships,
+		datasources.DbtProject,
+		datasources.DbtProjects,
+		datasources.DbtModels,
+		datasources.DbtTransformation,
+		datasources.ExternalLogging,
 	}
 }
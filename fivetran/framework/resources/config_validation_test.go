@@ -0,0 +1,105 @@
+package resources_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/resources"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// validateConfig runs ValidateConfig of the resource for the service and config fields, other attributes are null
+func validateConfig(r resource.Resource, service string, config map[string]tftypes.Value) diag.Diagnostics {
+	ctx := context.Background()
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	configType := objectType.AttributeTypes["config"].(tftypes.Object)
+
+	values := nullAttributes(objectType)
+	values["service"] = tftypes.NewValue(tftypes.String, service)
+	configValues := nullAttributes(configType)
+	for k, v := range config {
+		configValues[k] = v
+	}
+	values["config"] = tftypes.NewValue(configType, configValues)
+
+	resp := &resource.ValidateConfigResponse{}
+	r.(resource.ResourceWithValidateConfig).ValidateConfig(ctx, resource.ValidateConfigRequest{
+		Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)},
+	}, resp)
+	return resp.Diagnostics
+}
+
+func nullAttributes(objectType tftypes.Object) map[string]tftypes.Value {
+	result := map[string]tftypes.Value{}
+	for k, v := range objectType.AttributeTypes {
+		result[k] = tftypes.NewValue(v, nil)
+	}
+	return result
+}
+
+func assertSingleDiagnostic(t *testing.T, diags diag.Diagnostics, severity diag.Severity, detail string) {
+	t.Helper()
+	if len(diags) != 1 {
+		t.Fatalf("expected single diagnostic, got: %v", diags)
+	}
+	if diags[0].Severity() != severity || !strings.Contains(diags[0].Detail(), detail) {
+		t.Errorf("unexpected diagnostic: %v %v: %v", diags[0].Severity(), diags[0].Summary(), diags[0].Detail())
+	}
+}
+
+func TestConnectorConfigValidationUnsupportedFieldFails(t *testing.T) {
+	diags := validateConfig(resources.Connector(), "google_analytics", map[string]tftypes.Value{
+		"port": tftypes.NewValue(tftypes.Number, 123),
+	})
+	assertSingleDiagnostic(t, diags, diag.SeverityError, "Field `port` isn't supported for service `google_analytics`.")
+}
+
+func TestConnectorConfigValidationServiceSpecificFieldFails(t *testing.T) {
+	diags := validateConfig(resources.Connector(), "reddit_ads", map[string]tftypes.Value{
+		"accounts": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{
+			tftypes.NewValue(tftypes.String, "account"),
+		}),
+	})
+	assertSingleDiagnostic(t, diags, diag.SeverityError, "try use `accounts_reddit_ads` instead")
+}
+
+func TestConnectorConfigValidationSupportedFieldPasses(t *testing.T) {
+	diags := validateConfig(resources.Connector(), "google_ads", map[string]tftypes.Value{
+		"customer_id": tftypes.NewValue(tftypes.String, "customer_id"),
+	})
+	if len(diags) > 0 {
+		t.Errorf("unexpected diagnostics: %v", diags)
+	}
+}
+
+func TestConnectorConfigValidationIntegerItemFails(t *testing.T) {
+	diags := validateConfig(resources.Connector(), "yahoo_gemini", map[string]tftypes.Value{
+		"advertisers_id": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{
+			tftypes.NewValue(tftypes.String, "advertiser"),
+		}),
+	})
+	assertSingleDiagnostic(t, diags, diag.SeverityError, "Value `advertiser` should be an integer for service `yahoo_gemini`.")
+}
+
+func TestConnectorConfigValidationUnsupportedValueWarns(t *testing.T) {
+	diags := validateConfig(resources.Connector(), "google_ads", map[string]tftypes.Value{
+		"sync_mode": tftypes.NewValue(tftypes.String, "SomeAccounts"),
+	})
+	assertSingleDiagnostic(t, diags, diag.SeverityWarning, "Value `SomeAccounts` isn't supported for service `google_ads`")
+}
+
+func TestDestinationConfigValidationUnsupportedFieldFails(t *testing.T) {
+	diags := validateConfig(resources.Destination(), "snowflake", map[string]tftypes.Value{
+		"host":   tftypes.NewValue(tftypes.String, "host"),
+		"bucket": tftypes.NewValue(tftypes.String, "bucket"),
+	})
+	assertSingleDiagnostic(t, diags, diag.SeverityError, "Field `bucket` isn't supported for service `snowflake`.")
+}
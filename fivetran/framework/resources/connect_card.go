@@ -0,0 +1,112 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/fivetran/go-fivetran"
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core"
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core/model"
+	fivetranSchema "github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+func ConnectCard() resource.Resource {
+	return &connectCard{}
+}
+
+type connectCard struct {
+	core.ProviderResource
+}
+
+// Ensure the implementation satisfies the desired interfaces.
+var _ resource.ResourceWithConfigure = &connectCard{}
+
+func (r *connectCard) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_connect_card"
+}
+
+func (r *connectCard) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = fivetranSchema.GetConnectCardResourceSchema()
+}
+
+func (r *connectCard) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.GetClient() == nil {
+		resp.Diagnostics.AddError(
+			"Unconfigured Fivetran Client",
+			"Please report this issue to the provider developers.",
+		)
+
+		return
+	}
+
+	var data model.ConnectCard
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config := fivetran.NewConnectCardConfig()
+	if !data.RedirectUri.IsNull() && !data.RedirectUri.IsUnknown() {
+		config.RedirectUri(data.RedirectUri.ValueString())
+	}
+	if !data.HideSetupGuide.IsNull() && !data.HideSetupGuide.IsUnknown() {
+		config.HideSetupGuide(data.HideSetupGuide.ValueBool())
+	}
+
+	response, err := r.GetClient().NewConnectCard().
+		ConnectorId(data.ConnectorId.ValueString()).
+		Config(config).
+		Do(ctx)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Connect Card Resource.",
+			fmt.Sprintf("%v; code: %v; message: %v", err, response.Code, response.Message),
+		)
+		return
+	}
+
+	data.ReadFromResponse(response)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *connectCard) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// There is no API to read the Connect Card, it is kept in the state until the token expires
+	var data model.ConnectCard
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.IsExpired() {
+		// Expired Connect Card can't be used anymore, let Terraform generate a new one
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *connectCard) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// All the configurable fields require replacement, so just save the new plan
+	var plan, state model.ConnectCard
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	plan.Id = state.Id
+	plan.Uri = state.Uri
+	plan.Token = state.Token
+	plan.TokenExpiresAt = state.TokenExpiresAt
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *connectCard) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// do nothing
+}
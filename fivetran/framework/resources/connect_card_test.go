@@ -0,0 +1,150 @@
+package resources_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/fivetran/go-fivetran/tests/mock"
+	tfmock "github.com/fivetran/terraform-provider-fivetran/fivetran/tests/mock"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+const (
+	// JWT token with `exp` claim equal to 2100-01-01T00:00:00Z
+	connectCardTokenMock = "eyJhbGciOiJIUzI1NiJ9.eyJleHAiOjQxMDI0NDQ4MDB9.signature"
+	// JWT token with `exp` claim equal to 2001-09-09T01:46:40Z
+	connectCardExpiredTokenMock = "eyJhbGciOiJIUzI1NiJ9.eyJleHAiOjEwMDAwMDAwMDB9.signature"
+)
+
+func connectCardResponseMock(t *testing.T, token, redirectUri string) map[string]interface{} {
+	return tfmock.CreateMapFromJsonString(t, fmt.Sprintf(`
+	{
+		"connector_id": "connector_id",
+		"connect_card": {
+			"token": "%v",
+			"uri": "https://fivetran.com/connect-card/setup?auth=%v"
+		},
+		"connect_card_config": {
+			"redirect_uri": "%v",
+			"hide_setup_guide": true
+		}
+	}
+	`, token, token, redirectUri))
+}
+
+func TestResourceConnectCardMock(t *testing.T) {
+	var connectCardHandler *mock.Handler
+	redirectUri := "https://my.site/done"
+
+	step1 := resource.TestStep{
+		Config: `
+		resource "fivetran_connect_card" "test_connect_card" {
+			provider = fivetran-provider
+
+			connector_id = "connector_id"
+			redirect_uri = "https://my.site/done"
+			hide_setup_guide = true
+		}`,
+
+		Check: resource.ComposeAggregateTestCheckFunc(
+			func(s *terraform.State) error {
+				tfmock.AssertEqual(t, connectCardHandler.Interactions, 1)
+				return nil
+			},
+			resource.TestCheckResourceAttr("fivetran_connect_card.test_connect_card", "id", "connector_id"),
+			resource.TestCheckResourceAttr("fivetran_connect_card.test_connect_card", "token", connectCardTokenMock),
+			resource.TestCheckResourceAttr("fivetran_connect_card.test_connect_card", "uri", "https://fivetran.com/connect-card/setup?auth="+connectCardTokenMock),
+			resource.TestCheckResourceAttr("fivetran_connect_card.test_connect_card", "token_expires_at", "2100-01-01T00:00:00Z"),
+		),
+	}
+
+	step2 := resource.TestStep{
+		Config: `
+		resource "fivetran_connect_card" "test_connect_card" {
+			provider = fivetran-provider
+
+			connector_id = "connector_id"
+			redirect_uri = "https://my.site/finished"
+			hide_setup_guide = true
+		}`,
+
+		Check: resource.ComposeAggregateTestCheckFunc(
+			func(s *terraform.State) error {
+				// changed redirect_uri requires a new Connect Card
+				tfmock.AssertEqual(t, connectCardHandler.Interactions, 2)
+				return nil
+			},
+			resource.TestCheckResourceAttr("fivetran_connect_card.test_connect_card", "redirect_uri", "https://my.site/finished"),
+		),
+	}
+
+	resource.Test(
+		t,
+		resource.TestCase{
+			PreCheck: func() {
+				tfmock.MockClient().Reset()
+
+				connectCardHandler = tfmock.MockClient().When(http.MethodPost, "/v1/connectors/connector_id/connect-card").ThenCall(
+					func(req *http.Request) (*http.Response, error) {
+						body := tfmock.RequestBodyToJson(t, req)
+						config := tfmock.AssertKeyExists(t, body, "connect_card_config").(map[string]interface{})
+						redirectUri = tfmock.AssertKeyExists(t, config, "redirect_uri").(string)
+						tfmock.AssertKeyExistsAndHasValue(t, config, "hide_setup_guide", true)
+						return tfmock.FivetranSuccessResponse(t, req, http.StatusOK, "Connector Connect Card has been created",
+							connectCardResponseMock(t, connectCardTokenMock, redirectUri)), nil
+					},
+				)
+			},
+			ProtoV6ProviderFactories: tfmock.ProtoV6ProviderFactories,
+			CheckDestroy: func(s *terraform.State) error {
+				return nil
+			},
+			Steps: []resource.TestStep{
+				step1,
+				step2,
+			},
+		},
+	)
+}
+
+func TestResourceConnectCardExpiredTokenMock(t *testing.T) {
+	step1 := resource.TestStep{
+		Config: `
+		resource "fivetran_connect_card" "test_connect_card" {
+			provider = fivetran-provider
+
+			connector_id = "connector_id"
+		}`,
+
+		Check: resource.ComposeAggregateTestCheckFunc(
+			resource.TestCheckResourceAttr("fivetran_connect_card.test_connect_card", "token_expires_at", "2001-09-09T01:46:40Z"),
+		),
+		// expired Connect Card is removed from the state on refresh, so it is planned to be created again
+		ExpectNonEmptyPlan: true,
+	}
+
+	resource.Test(
+		t,
+		resource.TestCase{
+			PreCheck: func() {
+				tfmock.MockClient().Reset()
+
+				tfmock.MockClient().When(http.MethodPost, "/v1/connectors/connector_id/connect-card").ThenCall(
+					func(req *http.Request) (*http.Response, error) {
+						return tfmock.FivetranSuccessResponse(t, req, http.StatusOK, "Connector Connect Card has been created",
+							connectCardResponseMock(t, connectCardExpiredTokenMock, "")), nil
+					},
+				)
+			},
+			ProtoV6ProviderFactories: tfmock.ProtoV6ProviderFactories,
+			CheckDestroy: func(s *terraform.State) error {
+				return nil
+			},
+			Steps: []resource.TestStep{
+				step1,
+			},
+		},
+	)
+}
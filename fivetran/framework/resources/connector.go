@@ -3,11 +3,16 @@ package resources
 import (
 	"context"
 	"fmt"
+	"strings"
+	"time"
 
+	"github.com/fivetran/go-fivetran/connectors"
 	"github.com/fivetran/terraform-provider-fivetran/fivetran/common"
 	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core"
 	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core/model"
 	fivetranSchema "github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core/schema"
+	"github.com/fivetran/terraform-provider-fivetran/modules/fivetranapi"
+	"github.com/fivetran/terraform-provider-fivetran/modules/helpers"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -15,6 +20,11 @@ import (
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
+const (
+	connectorSetupDefaultTimeout = 30 * time.Minute
+	connectorSetupPollInterval   = 10 * time.Second
+)
+
 func Connector() resource.Resource {
 	return &connector{}
 }
@@ -27,6 +37,8 @@ type connector struct {
 var _ resource.ResourceWithConfigure = &connector{}
 var _ resource.ResourceWithUpgradeState = &connector{}
 var _ resource.ResourceWithImportState = &connector{}
+var _ resource.ResourceWithValidateConfig = &connector{}
+var _ resource.ResourceWithModifyPlan = &connector{}
 
 func (r *connector) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_connector"
@@ -34,14 +46,98 @@ func (r *connector) Metadata(ctx context.Context, req resource.MetadataRequest,
 
 func (r *connector) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Attributes: fivetranSchema.ConnectorAttributesSchema().GetResourceSchema(),
+		Attributes: fivetranSchema.ConnectorResourceAttributes(),
 		Blocks:     fivetranSchema.ConnectorResourceBlocks(ctx),
 		Version:    3,
 	}
 }
 
+// ImportState accepts connector id or `group_id/schema_name` (`group_id/schema.table` for single-table connectors)
 func (r *connector) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	groupId, schemaName, found := strings.Cut(req.ID, "/")
+	if !found {
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+
+	if groupId == "" || schemaName == "" {
+		resp.Diagnostics.AddError(
+			"Import error.",
+			fmt.Sprintf("Unexpected import identifier `%v`. Expected connector id, `group_id/schema_name` or `group_id/schema.table`.", req.ID),
+		)
+		return
+	}
+
+	if r.GetClient() == nil {
+		resp.Diagnostics.AddError(
+			"Unconfigured Fivetran Client",
+			"Please report this issue to the provider developers.",
+		)
+
+		return
+	}
+
+	id, log := r.findIdBySchema(ctx, groupId, schemaName)
+	if id == "" {
+		resp.Diagnostics.AddError(
+			"Import error.",
+			"Unable to find connector by import identifier `"+req.ID+"`."+log,
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+func (r *connector) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data model.ConnectorResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(data.ValidateConfig()...)
+}
+
+// ModifyPlan checks that the proxy agent set in `proxy_agent_id` exists
+func (r *connector) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.GetClient() == nil {
+		// resource is being destroyed or the provider is not configured yet
+		return
+	}
+
+	var proxyAgentId types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("proxy_agent_id"), &proxyAgentId)...)
+
+	if resp.Diagnostics.HasError() || proxyAgentId.IsNull() || proxyAgentId.IsUnknown() {
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		var stateProxyAgentId types.String
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("proxy_agent_id"), &stateProxyAgentId)...)
+		if proxyAgentId.Equal(stateProxyAgentId) {
+			return
+		}
+	}
+
+	response, err := fivetranapi.ProxyAgentDetailsById(ctx, r.GetClient(), proxyAgentId.ValueString())
+	if err != nil {
+		if helpers.IsNotFound(response.Code, err) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("proxy_agent_id"),
+				"Proxy Agent Not Found.",
+				fmt.Sprintf("Proxy agent with id `%v` doesn't exist.", proxyAgentId.ValueString()),
+			)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Unable to Validate Proxy Agent.",
+			fmt.Sprintf("%v; code: %v; message: %v", err, response.Code, response.Message),
+		)
+	}
 }
 
 func (r *connector) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
@@ -135,12 +231,17 @@ func (r *connector) Create(ctx context.Context, req resource.CreateRequest, resp
 	runSetupTestsPlan := core.GetBoolOrDefault(data.RunSetupTests, false)
 	trustCertificatesPlan := core.GetBoolOrDefault(data.TrustCertificates, false)
 	trustFingerprintsPlan := core.GetBoolOrDefault(data.TrustFingerprints, false)
+	waitForSetupPlan := core.GetBoolOrDefault(data.WaitForSetup, false)
+	failOnSetupTestFailurePlan := core.GetBoolOrDefault(data.FailOnSetupTestFailure, false)
+
+	// setup tests can't pass before the connection method is set, so they are performed on networking update
+	hasNetworking := data.HasNetworking()
 
 	svc := r.GetClient().NewConnectorCreate().
 		Paused(true). // on creation we always create paused connector
 		Service(data.Service.ValueString()).
 		GroupID(data.GroupId.ValueString()).
-		RunSetupTests(runSetupTestsPlan).
+		RunSetupTests(runSetupTestsPlan && !hasNetworking).
 		TrustCertificates(trustCertificatesPlan).
 		TrustFingerprints(trustFingerprintsPlan).
 		ConfigCustom(&configMap) // on creation we have config always with schema params
@@ -161,11 +262,71 @@ func (r *connector) Create(ctx context.Context, req resource.CreateRequest, resp
 		return
 	}
 
-	data.ReadFromCreateResponse(response)
-
 	data.RunSetupTests = types.BoolValue(runSetupTestsPlan)
 	data.TrustCertificates = types.BoolValue(trustCertificatesPlan)
 	data.TrustFingerprints = types.BoolValue(trustFingerprintsPlan)
+	data.WaitForSetup = types.BoolValue(waitForSetupPlan)
+	data.FailOnSetupTestFailure = types.BoolValue(failOnSetupTestFailurePlan)
+
+	if hasNetworking {
+		networkingRequest := data.GetNetworkingModifyRequest()
+		networkingRequest.RunSetupTests = &runSetupTestsPlan
+		networkingRequest.TrustCertificates = &trustCertificatesPlan
+		networkingRequest.TrustFingerprints = &trustFingerprintsPlan
+
+		networkingResponse, err := fivetranapi.ModifyConnectorNetworking(ctx, r.GetClient(), response.Data.ID, networkingRequest)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Create Connector Resource.",
+				fmt.Sprintf("Error while setting connection method. %v; code: %v; message: %v", err, networkingResponse.Code, networkingResponse.Message),
+			)
+			// connector is already created, save it to the state to be able to fix or re-create it
+			data.ReadFromCreateResponse(response)
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			return
+		}
+		response = networkingResponse
+	}
+
+	data.ReadFromCreateResponse(response)
+
+	if failOnSetupTestFailurePlan {
+		if failedTests := data.GetFailedSetupTests(); len(failedTests) > 0 {
+			resp.Diagnostics.AddError(
+				"Connector Setup Tests Failed.",
+				strings.Join(failedTests, "\n"),
+			)
+			// connector is already created, save it to the state to be able to fix or re-create it
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			return
+		}
+	}
+
+	if waitForSetupPlan {
+		createTimeout, diags := data.Timeouts.Create(ctx, connectorSetupDefaultTimeout)
+		resp.Diagnostics.Append(diags...)
+
+		if resp.Diagnostics.HasError() {
+			// connector is already created, save it to the state anyway
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			return
+		}
+
+		waitCtx, cancel := helpers.SetContextTimeout(ctx, createTimeout)
+		defer cancel()
+
+		detailsResponse, err := r.waitForSetup(waitCtx, data.Id.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Create Connector Resource.",
+				fmt.Sprintf("%v; code: %v; message: %v", err, detailsResponse.Code, detailsResponse.Message),
+			)
+			// connector is already created, save it to the state to be able to fix or re-create it
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			return
+		}
+		data.ReadFromResponse(detailsResponse)
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -200,9 +361,14 @@ func (r *connector) Read(ctx context.Context, req resource.ReadRequest, resp *re
 		id = recoveredId
 	}
 
-	response, err := r.GetClient().NewConnectorDetails().ConnectorID(id).DoCustom(ctx)
+	response, networkingResponse, err := fivetranapi.ConnectorDetails(ctx, r.GetClient(), id)
 
 	if err != nil {
+		// If the resource does not exist (404), inform Terraform.
+		if helpers.IsNotFound(response.Code, err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Read error.",
 			fmt.Sprintf("%v; code: %v; message: %v", err, response.Code, response.Message),
@@ -210,7 +376,20 @@ func (r *connector) Read(ctx context.Context, req resource.ReadRequest, resp *re
 		return
 	}
 
+	connectedBy := data.ConnectedBy
+	previousTimestamps, diags := req.Private.GetKey(ctx, model.AuthTimestampsPrivateKey)
+	resp.Diagnostics.Append(diags...)
+
 	data.ReadFromResponse(response)
+
+	timestamps := model.ReadAuthTimestamps(response.Data.Config)
+	resp.Diagnostics.Append(data.CheckCredentialsChanged(connectedBy, previousTimestamps, timestamps)...)
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, model.AuthTimestampsPrivateKey, timestamps)...)
+
+	if data.HasNetworking() {
+		data.ReadNetworking(networkingResponse)
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -283,7 +462,31 @@ func (r *connector) Update(ctx context.Context, req resource.UpdateRequest, resp
 	patch := model.PrepareConfigAuthPatch(stateConfigMap, planConfigMap, plan.Service.ValueString(), common.GetConfigFieldsMap())
 	authPatch := model.PrepareConfigAuthPatch(stateAuthMap, planAuthMap, plan.Service.ValueString(), common.GetAuthFieldsMap())
 
+	if plan.CredentialsVersionChanged(state) {
+		// sensitive values are masked by the API, so they are sent again regardless of the state
+		model.AddSensitiveFields(patch, planConfigMap, plan.Service.ValueString(), common.GetConfigFieldsMap())
+		model.AddSensitiveFields(authPatch, planAuthMap, plan.Service.ValueString(), common.GetAuthFieldsMap())
+	}
+
 	updatePerformed := false
+	if plan.NetworkingChanged(state) {
+		networkingRequest := plan.GetNetworkingModifyRequest()
+		networkingRequest.RunSetupTests = &runSetupTestsPlan
+		networkingRequest.TrustCertificates = &trustCertificatesPlan
+		networkingRequest.TrustFingerprints = &trustFingerprintsPlan
+
+		response, err := fivetranapi.ModifyConnectorNetworking(ctx, r.GetClient(), state.Id.ValueString(), networkingRequest)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Update Connector Resource.",
+				fmt.Sprintf("Error while setting connection method. %v; code: %v; message: %v", err, response.Code, response.Message),
+			)
+			return
+		}
+		plan.ReadFromCreateResponse(response)
+		updatePerformed = true
+	}
+
 	if len(patch) > 0 || len(authPatch) > 0 {
 		svc := r.GetClient().NewConnectorModify().
 			RunSetupTests(runSetupTestsPlan).
@@ -309,7 +512,7 @@ func (r *connector) Update(ctx context.Context, req resource.UpdateRequest, resp
 		}
 		plan.ReadFromCreateResponse(response)
 		updatePerformed = true
-	} else {
+	} else if !updatePerformed {
 		// If values of testing fields changed we should run tests
 		if runSetupTestsPlan && runSetupTestsPlan != runSetupTestsState ||
 			trustCertificatesPlan && trustCertificatesPlan != trustCertificatesState ||
@@ -351,6 +554,24 @@ func (r *connector) Update(ctx context.Context, req resource.UpdateRequest, resp
 	if plan.TrustFingerprints.IsUnknown() {
 		plan.TrustFingerprints = state.TrustFingerprints
 	}
+	if plan.WaitForSetup.IsUnknown() {
+		plan.WaitForSetup = state.WaitForSetup
+	}
+	if plan.FailOnSetupTestFailure.IsUnknown() {
+		plan.FailOnSetupTestFailure = state.FailOnSetupTestFailure
+	}
+	if plan.SetupTests.IsUnknown() {
+		plan.SetupTests = state.SetupTests
+	}
+
+	if updatePerformed && core.GetBoolOrDefault(plan.FailOnSetupTestFailure, false) {
+		if failedTests := plan.GetFailedSetupTests(); len(failedTests) > 0 {
+			resp.Diagnostics.AddError(
+				"Connector Setup Tests Failed.",
+				strings.Join(failedTests, "\n"),
+			)
+		}
+	}
 
 	// Save state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
@@ -407,50 +628,80 @@ func (r *connector) recoverId(ctx context.Context, data model.ConnectorResourceM
 		}
 		log = log + "\n" + fmt.Sprintf("Schema `%s`, group `%s", schemaName, groupId)
 		if schemaName != "" && groupId != "" {
-			connectorsList, err := r.
-				GetClient().
-				NewGroupListConnectors().
-				GroupID(groupId).
-				Limit(1000).
-				Do(ctx)
-			found := false
-			if err == nil {
-				for _, c := range connectorsList.Data.Items {
-					if c.Schema == schemaName {
-						id = c.ID
-						found = true
-						break
-					}
-				}
-				for !found && connectorsList.Data.NextCursor != "" {
-					connectorsList, err = r.GetClient().
-						NewGroupListConnectors().
-						GroupID(groupId).
-						Limit(1000).
-						Cursor(connectorsList.Data.NextCursor).
-						Do(ctx)
-					if err != nil {
-						log = log + "\n" + err.Error()
-						break
-					} else {
-						for _, c := range connectorsList.Data.Items {
-							if c.Schema == schemaName {
-								id = c.ID
-								found = true
-								break
-							}
-						}
-					}
-				}
-			} else {
-				log = log + "\n" + err.Error()
-			}
-			if !found {
-				log = log + "\n" + fmt.Sprintf("Can't find connector with schema = `%s` in group with id = `%s", schemaName, groupId)
-			}
+			var findLog string
+			id, findLog = r.findIdBySchema(ctx, groupId, schemaName)
+			log = log + findLog
 		} else {
 			log = log + "\n" + " not enough data in state for recovery: " + fmt.Sprintf("schema:`%s`, group:`%s", schemaName, groupId)
 		}
 	}
 	return id, log
 }
+
+// waitForSetup polls connector details until the connector setup state becomes `connected`, fails if it becomes `broken`
+func (r *connector) waitForSetup(ctx context.Context, connectorId string) (connectors.DetailsWithCustomConfigNoTestsResponse, error) {
+	for {
+		response, err := r.GetClient().NewConnectorDetails().ConnectorID(connectorId).DoCustom(ctx)
+		if err != nil {
+			return response, err
+		}
+
+		switch response.Data.Status.SetupState {
+		case "connected":
+			return response, nil
+		case "broken":
+			return response, fmt.Errorf("setup state of connector %v is broken%v", connectorId, describeConnectorStatus(response.Data.Status))
+		}
+
+		if err := helpers.ContextDelay(ctx, connectorSetupPollInterval); err != nil {
+			return response, fmt.Errorf("setup state of connector %v is `%v`, expected `connected`: %v%v",
+				connectorId, response.Data.Status.SetupState, err, describeConnectorStatus(response.Data.Status))
+		}
+	}
+}
+
+// describeConnectorStatus lists connector status tasks and warnings
+func describeConnectorStatus(status connectors.StatusResponse) string {
+	result := ""
+	for _, t := range status.Tasks {
+		result = result + fmt.Sprintf("\n - task: %v: %v", t.Code, t.Message)
+	}
+	for _, w := range status.Warnings {
+		result = result + fmt.Sprintf("\n - warning: %v: %v", w.Code, w.Message)
+	}
+	return result
+}
+
+// findIdBySchema looks for the connector with the given destination schema name (`schema` or `schema.table`) in the group
+func (r *connector) findIdBySchema(ctx context.Context, groupId, schemaName string) (string, string) {
+	log := ""
+	connectorsList, err := r.
+		GetClient().
+		NewGroupListConnectors().
+		GroupID(groupId).
+		Limit(1000).
+		Do(ctx)
+	if err != nil {
+		return "", log + "\n" + fmt.Sprintf("%v; code: %v; message: %v", err, connectorsList.Code, connectorsList.Message)
+	}
+	for {
+		for _, c := range connectorsList.Data.Items {
+			if c.Schema == schemaName {
+				return c.ID, log
+			}
+		}
+		if connectorsList.Data.NextCursor == "" {
+			break
+		}
+		connectorsList, err = r.GetClient().
+			NewGroupListConnectors().
+			GroupID(groupId).
+			Limit(1000).
+			Cursor(connectorsList.Data.NextCursor).
+			Do(ctx)
+		if err != nil {
+			return "", log + "\n" + fmt.Sprintf("%v; code: %v; message: %v", err, connectorsList.Code, connectorsList.Message)
+		}
+	}
+	return "", log + "\n" + fmt.Sprintf("Can't find connector with schema = `%s` in group with id = `%s`", schemaName, groupId)
+}
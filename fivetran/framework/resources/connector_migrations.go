@@ -49,6 +49,16 @@ func upgradeConnectorState(ctx context.Context, req resource.UpgradeStateRequest
 			"run_setup_tests":    convertStringStateValueToBool("run_setup_tests", rawState["run_setup_tests"], resp.Diagnostics),
 			"trust_fingerprints": convertStringStateValueToBool("trust_fingerprints", rawState["trust_fingerprints"], resp.Diagnostics),
 			"trust_certificates": convertStringStateValueToBool("trust_certificates", rawState["trust_certificates"], resp.Diagnostics),
+			"wait_for_setup":     tftypes.NewValue(tftypes.Bool, nil),
+
+			"fail_on_setup_test_failure": tftypes.NewValue(tftypes.Bool, nil),
+			"networking_method":          tftypes.NewValue(tftypes.String, nil),
+			"private_link_id":            tftypes.NewValue(tftypes.String, nil),
+			"proxy_agent_id":             tftypes.NewValue(tftypes.String, nil),
+			"setup_tests":                tftypes.NewValue(setupTestsStateType, nil),
+			"config_json":                tftypes.NewValue(tftypes.String, nil),
+			"auth_json":                  tftypes.NewValue(tftypes.String, nil),
+			"credentials_version":        tftypes.NewValue(tftypes.Number, nil),
 
 			"config": convertSetToBlock("config", rawState["config"], model.GetTfTypes(common.GetConfigFieldsMap(), 3), model.GetTfTypes(common.GetConfigFieldsMap(), fromVersion), resp.Diagnostics),
 			"auth":   convertSetToBlock("auth", rawState["auth"], model.GetTfTypes(common.GetAuthFieldsMap(), 3), model.GetTfTypes(common.GetAuthFieldsMap(), fromVersion), resp.Diagnostics),
@@ -70,6 +80,16 @@ func upgradeConnectorState(ctx context.Context, req resource.UpgradeStateRequest
 	resp.DynamicValue = &dynamicValue
 }
 
+var setupTestsStateType = tftypes.List{
+	ElementType: tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"title":   tftypes.String,
+			"status":  tftypes.String,
+			"message": tftypes.String,
+		},
+	},
+}
+
 func getConnectorStateModel(version int) tftypes.Type {
 	dsObj := tftypes.Object{
 		AttributeTypes: map[string]tftypes.Type{
@@ -98,6 +118,15 @@ func getConnectorStateModel(version int) tftypes.Type {
 		base["run_setup_tests"] = tftypes.Bool
 		base["trust_certificates"] = tftypes.Bool
 		base["trust_fingerprints"] = tftypes.Bool
+		base["wait_for_setup"] = tftypes.Bool
+		base["fail_on_setup_test_failure"] = tftypes.Bool
+		base["networking_method"] = tftypes.String
+		base["private_link_id"] = tftypes.String
+		base["proxy_agent_id"] = tftypes.String
+		base["setup_tests"] = setupTestsStateType
+		base["config_json"] = tftypes.String
+		base["auth_json"] = tftypes.String
+		base["credentials_version"] = tftypes.Number
 
 		base["config"] = tftypes.Object{AttributeTypes: model.GetTfTypes(common.GetConfigFieldsMap(), 3)}
 		base["auth"] = tftypes.Object{AttributeTypes: model.GetTfTypes(common.GetAuthFieldsMap(), 3)}
@@ -106,17 +106,15 @@ func (r *connectorSchedule) Read(ctx context.Context, req resource.ReadRequest,
 	connectorResponse, err := r.GetClient().NewConnectorDetails().ConnectorID(data.Id.ValueString()).DoCustom(ctx)
 
 	if err != nil {
-		if connectorResponse.Code == "NotFound_Connector" {
-			resp.Diagnostics.AddError(
-				"Unable to Read Connector Schedule Resource.",
-				"Connector with id = "+data.ConnectorId.ValueString()+" doesn't exist.",
-			)
-		} else {
-			resp.Diagnostics.AddError(
-				"Unable to Read Connector Schedule Resource.",
-				fmt.Sprintf("%v; code: %v; message: %v", err, connectorResponse.Code, connectorResponse.Message),
-			)
+		// If the connector does not exist (404), inform Terraform.
+		if helpers.IsNotFound(connectorResponse.Code, err) {
+			resp.State.RemoveResource(ctx)
+			return
 		}
+		resp.Diagnostics.AddError(
+			"Unable to Read Connector Schedule Resource.",
+			fmt.Sprintf("%v; code: %v; message: %v", err, connectorResponse.Code, connectorResponse.Message),
+		)
 		return
 	}
 	data.ReadFromResponse(connectorResponse)
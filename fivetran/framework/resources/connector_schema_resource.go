@@ -3,11 +3,14 @@ package resources
 import (
 	"context"
 	"fmt"
+	"sort"
 
+	"github.com/fivetran/go-fivetran/common"
 	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core"
 	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core/model"
 	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core/schema"
 	configSchema "github.com/fivetran/terraform-provider-fivetran/modules/connector/schema"
+	"github.com/fivetran/terraform-provider-fivetran/modules/helpers"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -145,6 +148,15 @@ func (r *connectorSchema) Create(ctx context.Context, req resource.CreateRequest
 			)
 			return
 		}
+
+		resyncResponse, err := r.resyncTables(ctx, connectorID, config)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Create Connector Schema Resource.",
+				fmt.Sprintf("Error while re-syncing changed tables. %v; code: %v; message: %v", err, resyncResponse.Code, resyncResponse.Message),
+			)
+			return
+		}
 	}
 	// read data from response and merge with existing config
 	data.ReadFromResponse(schemaResponse)
@@ -174,6 +186,11 @@ func (r *connectorSchema) Read(ctx context.Context, req resource.ReadRequest, re
 
 	schemaResponse, err := client.NewConnectorSchemaDetails().ConnectorID(connectorID).Do(ctx)
 	if err != nil {
+		// If the resource does not exist (404), inform Terraform.
+		if helpers.IsNotFound(schemaResponse.Code, err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Unable to Read Connector Schema Resource.",
 			fmt.Sprintf("Error wile retrieving existing schema. %v; code: %v; message: %v", err, schemaResponse.Code, schemaResponse.Message),
@@ -258,6 +275,15 @@ func (r *connectorSchema) Update(ctx context.Context, req resource.UpdateRequest
 			return
 		}
 
+		resyncResponse, err := r.resyncTables(ctx, connectorID, config)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Update Connector Schema Resource.",
+				fmt.Sprintf("Error while re-syncing changed tables. %v; code: %v; message: %v", err, resyncResponse.Code, resyncResponse.Message),
+			)
+			return
+		}
+
 	}
 	// read data from response and merge with existing config
 	plan.ReadFromResponse(schemaResponse)
@@ -265,6 +291,33 @@ func (r *connectorSchema) Update(ctx context.Context, req resource.UpdateRequest
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
+// resyncTables triggers re-sync of the tables patched with the config that have resync_on_change enabled
+func (r *connectorSchema) resyncTables(ctx context.Context, connectorID string, config configSchema.SchemaConfig) (common.CommonResponse, error) {
+	tablesToResync := config.GetTablesToResync()
+
+	schemaNames := make([]string, 0, len(tablesToResync))
+	for schemaName := range tablesToResync {
+		schemaNames = append(schemaNames, schemaName)
+	}
+	sort.Strings(schemaNames)
+
+	for _, schemaName := range schemaNames {
+		tableNames := tablesToResync[schemaName]
+		sort.Strings(tableNames)
+		for _, tableName := range tableNames {
+			response, err := r.GetClient().NewConnectorReSyncTable().
+				ConnectorID(connectorID).
+				Schema(schemaName).
+				Table(tableName).
+				Do(ctx)
+			if err != nil {
+				return response, fmt.Errorf("unable to re-sync table %v.%v: %v", schemaName, tableName, err)
+			}
+		}
+	}
+	return common.CommonResponse{}, nil
+}
+
 func (r *connectorSchema) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	// Nothing to do
 }
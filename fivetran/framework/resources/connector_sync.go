@@ -0,0 +1,175 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/fivetran/go-fivetran/connectors"
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core"
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core/model"
+	fivetranSchema "github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core/schema"
+	"github.com/fivetran/terraform-provider-fivetran/modules/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+const (
+	connectorSyncDefaultTimeout = 60 * time.Minute
+	connectorSyncPollInterval   = 10 * time.Second
+)
+
+func ConnectorSync() resource.Resource {
+	return &connectorSync{}
+}
+
+type connectorSync struct {
+	core.ProviderResource
+}
+
+// Ensure the implementation satisfies the desired interfaces.
+var _ resource.ResourceWithConfigure = &connectorSync{}
+
+func (r *connectorSync) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_connector_sync"
+}
+
+func (r *connectorSync) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = fivetranSchema.GetConnectorSyncResourceSchema(ctx)
+}
+
+func (r *connectorSync) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.GetClient() == nil {
+		resp.Diagnostics.AddError(
+			"Unconfigured Fivetran Client",
+			"Please report this issue to the provider developers.",
+		)
+
+		return
+	}
+
+	var data model.ConnectorSync
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, connectorSyncDefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helpers.SetContextTimeout(ctx, createTimeout)
+	defer cancel()
+
+	client := r.GetClient()
+	connectorId := data.ConnectorId.ValueString()
+
+	// remember the last sync results to be able to recognize the result of the triggered sync
+	detailsResponse, err := client.NewConnectorDetails().ConnectorID(connectorId).DoCustom(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Connector Sync Resource.",
+			fmt.Sprintf("%v; code: %v; message: %v", err, detailsResponse.Code, detailsResponse.Message),
+		)
+		return
+	}
+
+	succeededAt := detailsResponse.Data.SucceededAt
+	failedAt := detailsResponse.Data.FailedAt
+
+	if core.GetBoolOrDefault(data.HistoricalResync, false) {
+		modifyResponse, err := client.NewConnectorModify().
+			ConnectorID(connectorId).
+			IsHistoricalSync(true).
+			DoCustom(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Create Connector Sync Resource.",
+				fmt.Sprintf("Error while requesting historical re-sync. %v; code: %v; message: %v", err, modifyResponse.Code, modifyResponse.Message),
+			)
+			return
+		}
+	}
+
+	syncResponse, err := client.NewConnectorSync().ConnectorID(connectorId).Do(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Connector Sync Resource.",
+			fmt.Sprintf("Error while triggering sync. %v; code: %v; message: %v", err, syncResponse.Code, syncResponse.Message),
+		)
+		return
+	}
+
+	if core.GetBoolOrDefault(data.WaitForCompletion, false) {
+		detailsResponse, err = r.waitForSync(ctx, connectorId, succeededAt, failedAt)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Create Connector Sync Resource.",
+				fmt.Sprintf("%v; code: %v; message: %v", err, detailsResponse.Code, detailsResponse.Message),
+			)
+			return
+		}
+	}
+
+	data.ReadFromResponse(detailsResponse)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *connectorSync) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Sync is a one-off action, there is nothing to refresh
+	var data model.ConnectorSync
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *connectorSync) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// All the fields that affect the sync require replacement, so just save the new plan
+	var plan, state model.ConnectorSync
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	plan.Id = state.Id
+	plan.SucceededAt = state.SucceededAt
+	plan.FailedAt = state.FailedAt
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *connectorSync) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// do nothing
+}
+
+// waitForSync polls connector details until the sync that was triggered after the succeededAt/failedAt timestamps is finished
+func (r *connectorSync) waitForSync(
+	ctx context.Context,
+	connectorId string,
+	succeededAt, failedAt time.Time) (connectors.DetailsWithCustomConfigNoTestsResponse, error) {
+	for {
+		response, err := r.GetClient().NewConnectorDetails().ConnectorID(connectorId).DoCustom(ctx)
+		if err != nil {
+			return response, err
+		}
+
+		if response.Data.Status.SyncState != "syncing" {
+			succeeded := response.Data.SucceededAt.After(succeededAt)
+			failed := response.Data.FailedAt.After(failedAt)
+
+			if failed && (!succeeded || response.Data.FailedAt.After(response.Data.SucceededAt)) {
+				return response, fmt.Errorf("sync of connector %v failed at %v", connectorId, response.Data.FailedAt.String())
+			}
+			if succeeded {
+				return response, nil
+			}
+		}
+
+		if err := helpers.ContextDelay(ctx, connectorSyncPollInterval); err != nil {
+			return response, fmt.Errorf("sync of connector %v is not finished: %v", connectorId, err)
+		}
+	}
+}
@@ -0,0 +1,155 @@
+package resources_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/fivetran/go-fivetran/tests/mock"
+	tfmock "github.com/fivetran/terraform-provider-fivetran/fivetran/tests/mock"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestResourceConnectorSyncMock(t *testing.T) {
+	var syncHandler *mock.Handler
+	var patchHandler *mock.Handler
+
+	succeededAt := "2020-03-17T12:31:40.870504Z"
+	isHistoricalSync := false
+
+	createResponse := func() string {
+		connectorResponseTemplate := `
+		{
+			"id": "connector_id",
+			"group_id": "group_id",
+			"service": "service_type",
+			"service_version": 0,
+			"schema": "schema_name",
+			"connected_by": "user_id",
+			"created_at": "2020-03-11T15:03:55.743708Z",
+			"succeeded_at": "%v",
+			"failed_at": "2021-01-15T10:55:00.056497Z",
+			"status": {
+				"setup_state": "connected",
+				"schema_status": "ready",
+				"sync_state": "scheduled",
+				"update_state": "on_schedule",
+				"is_historical_sync": %v,
+				"tasks": [],
+				"warnings": []
+			},
+			"config": {},
+			"paused": false,
+			"pause_after_trial": false,
+			"sync_frequency": 360,
+			"schedule_type": "auto"
+		}
+		`
+		return fmt.Sprintf(connectorResponseTemplate, succeededAt, isHistoricalSync)
+	}
+
+	preCheckFunc := func() {
+		tfmock.MockClient().Reset()
+		tfmock.MockClient().When(http.MethodGet, "/v1/connectors/connector_id").ThenCall(
+			func(req *http.Request) (*http.Response, error) {
+				return tfmock.FivetranSuccessResponse(t, req, http.StatusOK, "Success", tfmock.CreateMapFromJsonString(t, createResponse())), nil
+			},
+		)
+
+		patchHandler = tfmock.MockClient().When(http.MethodPatch, "/v1/connectors/connector_id").ThenCall(
+			func(req *http.Request) (*http.Response, error) {
+				body := tfmock.RequestBodyToJson(t, req)
+				tfmock.AssertKeyExistsAndHasValue(t, body, "is_historical_sync", true)
+				isHistoricalSync = true
+				return tfmock.FivetranSuccessResponse(t, req, http.StatusOK, "Success", tfmock.CreateMapFromJsonString(t, createResponse())), nil
+			},
+		)
+
+		syncHandler = tfmock.MockClient().When(http.MethodPost, "/v1/connectors/connector_id/force").ThenCall(
+			func(req *http.Request) (*http.Response, error) {
+				// emulate instant sync
+				if syncHandler.Interactions == 1 {
+					succeededAt = "2023-03-17T12:31:40.870504Z"
+				} else {
+					succeededAt = "2023-03-18T12:31:40.870504Z"
+				}
+				isHistoricalSync = false
+				return tfmock.FivetranSuccessResponse(t, req, http.StatusOK, "Sync has been successfully triggered for connector with id = connector_id", nil), nil
+			},
+		)
+	}
+
+	resource.Test(
+		t,
+		resource.TestCase{
+			PreCheck:                 preCheckFunc,
+			ProtoV6ProviderFactories: tfmock.ProtoV6ProviderFactories,
+			CheckDestroy: func(s *terraform.State) error {
+				return nil
+			},
+			Steps: []resource.TestStep{
+				{
+					Config: `
+					resource "fivetran_connector_sync" "test_connector_sync" {
+						provider = fivetran-provider
+						connector_id = "connector_id"
+						wait_for_completion = true
+						triggers = {
+							version = "1"
+						}
+					}`,
+
+					Check: resource.ComposeAggregateTestCheckFunc(
+						func(s *terraform.State) error {
+							tfmock.AssertEqual(t, syncHandler.Interactions, 1)
+							tfmock.AssertEqual(t, patchHandler.Interactions, 0)
+							return nil
+						},
+						resource.TestCheckResourceAttr("fivetran_connector_sync.test_connector_sync", "id", "connector_id"),
+						resource.TestCheckResourceAttr("fivetran_connector_sync.test_connector_sync", "succeeded_at", "2023-03-17 12:31:40.870504 +0000 UTC"),
+					),
+				},
+				{
+					Config: `
+					resource "fivetran_connector_sync" "test_connector_sync" {
+						provider = fivetran-provider
+						connector_id = "connector_id"
+						wait_for_completion = false
+						triggers = {
+							version = "1"
+						}
+					}`,
+
+					Check: resource.ComposeAggregateTestCheckFunc(
+						func(s *terraform.State) error {
+							tfmock.AssertEqual(t, syncHandler.Interactions, 1)
+							return nil
+						},
+					),
+				},
+				{
+					Config: `
+					resource "fivetran_connector_sync" "test_connector_sync" {
+						provider = fivetran-provider
+						connector_id = "connector_id"
+						wait_for_completion = true
+						historical_resync = true
+						triggers = {
+							version = "2"
+						}
+					}`,
+
+					Check: resource.ComposeAggregateTestCheckFunc(
+						func(s *terraform.State) error {
+							tfmock.AssertEqual(t, syncHandler.Interactions, 2)
+							tfmock.AssertEqual(t, patchHandler.Interactions, 1)
+							return nil
+						},
+						resource.TestCheckResourceAttr("fivetran_connector_sync.test_connector_sync", "succeeded_at", "2023-03-18 12:31:40.870504 +0000 UTC"),
+					),
+				},
+			},
+		},
+	)
+}
@@ -3,6 +3,7 @@ package resources_test
 import (
 	"fmt"
 	"net/http"
+	"regexp"
 	"testing"
 
 	"github.com/fivetran/go-fivetran/tests/mock"
@@ -66,20 +67,20 @@ func TestResourceConnectorMock(t *testing.T) {
 			}
 
 			config {
-				user = "user_name"
-				password = "password"
-				port = 5432
-				account_ids = ["id1", "id2", "id3"]
+				customer_id = "customer_id"
+				sync_mode = "SpecificAccounts"
+				conversion_window_size = 30
+				accounts = ["id1", "id2", "id3"]
 
 				reports {
 					table = "table1"
 					report_type = "report_1"
-					metrics = ["metric1", "metric2"]
+					fields = ["metric1", "metric2"]
 				}
 				reports {
 					table = "table2"
 					report_type = "report_2"
-					metrics = ["metric2", "metric3"]
+					fields = ["metric2", "metric3"]
 				}
 			}
 		}`,
@@ -94,18 +95,18 @@ func TestResourceConnectorMock(t *testing.T) {
 			resource.TestCheckResourceAttr("fivetran_connector.test_connector", "group_id", "group_id"),
 			resource.TestCheckResourceAttr("fivetran_connector.test_connector", "service", "google_ads"),
 			resource.TestCheckResourceAttr("fivetran_connector.test_connector", "destination_schema.name", "adwords_schema"),
-			resource.TestCheckResourceAttr("fivetran_connector.test_connector", "config.user", "user_name"),
-			resource.TestCheckResourceAttr("fivetran_connector.test_connector", "config.password", "password"),
-			resource.TestCheckResourceAttr("fivetran_connector.test_connector", "config.port", "5432"),
-			resource.TestCheckResourceAttr("fivetran_connector.test_connector", "config.account_ids.0", "id1"),
-			resource.TestCheckResourceAttr("fivetran_connector.test_connector", "config.account_ids.1", "id2"),
-			resource.TestCheckResourceAttr("fivetran_connector.test_connector", "config.account_ids.2", "id3"),
+			resource.TestCheckResourceAttr("fivetran_connector.test_connector", "config.customer_id", "customer_id"),
+			resource.TestCheckResourceAttr("fivetran_connector.test_connector", "config.sync_mode", "SpecificAccounts"),
+			resource.TestCheckResourceAttr("fivetran_connector.test_connector", "config.conversion_window_size", "30"),
+			resource.TestCheckResourceAttr("fivetran_connector.test_connector", "config.accounts.0", "id1"),
+			resource.TestCheckResourceAttr("fivetran_connector.test_connector", "config.accounts.1", "id2"),
+			resource.TestCheckResourceAttr("fivetran_connector.test_connector", "config.accounts.2", "id3"),
 			resource.TestCheckResourceAttr("fivetran_connector.test_connector", "config.reports.0.report_type", "report_1"),
-			resource.TestCheckResourceAttr("fivetran_connector.test_connector", "config.reports.0.metrics.0", "metric1"),
-			resource.TestCheckResourceAttr("fivetran_connector.test_connector", "config.reports.0.metrics.1", "metric2"),
+			resource.TestCheckResourceAttr("fivetran_connector.test_connector", "config.reports.0.fields.0", "metric1"),
+			resource.TestCheckResourceAttr("fivetran_connector.test_connector", "config.reports.0.fields.1", "metric2"),
 			resource.TestCheckResourceAttr("fivetran_connector.test_connector", "config.reports.1.report_type", "report_2"),
-			resource.TestCheckResourceAttr("fivetran_connector.test_connector", "config.reports.1.metrics.0", "metric2"),
-			resource.TestCheckResourceAttr("fivetran_connector.test_connector", "config.reports.1.metrics.1", "metric3"),
+			resource.TestCheckResourceAttr("fivetran_connector.test_connector", "config.reports.1.fields.0", "metric2"),
+			resource.TestCheckResourceAttr("fivetran_connector.test_connector", "config.reports.1.fields.1", "metric3"),
 		),
 	}
 
@@ -126,15 +127,15 @@ func TestResourceConnectorMock(t *testing.T) {
 			trust_fingerprints = true
 
 			config {
-				user = "user_name_1"
-				password = "password_1"
-				port = 2345
-				always_encrypted = false
+				customer_id = "customer_id_1"
+				sync_mode = "ManagerAccounts"
+				conversion_window_size = 60
+				timeframe_months = "SIX"
 
 				reports {
 					table = "table1"
 					report_type = "report_1"
-					metrics = ["metric1", "metric2"]
+					fields = ["metric1", "metric2"]
 				}
 			}
 		}`,
@@ -149,13 +150,13 @@ func TestResourceConnectorMock(t *testing.T) {
 			resource.TestCheckResourceAttr("fivetran_connector.test_connector", "group_id", "group_id"),
 			resource.TestCheckResourceAttr("fivetran_connector.test_connector", "service", "google_ads"),
 			resource.TestCheckResourceAttr("fivetran_connector.test_connector", "destination_schema.name", "adwords_schema"),
-			resource.TestCheckResourceAttr("fivetran_connector.test_connector", "config.user", "user_name_1"),
-			resource.TestCheckResourceAttr("fivetran_connector.test_connector", "config.password", "password_1"),
-			resource.TestCheckResourceAttr("fivetran_connector.test_connector", "config.port", "2345"),
-			resource.TestCheckResourceAttr("fivetran_connector.test_connector", "config.always_encrypted", "false"),
+			resource.TestCheckResourceAttr("fivetran_connector.test_connector", "config.customer_id", "customer_id_1"),
+			resource.TestCheckResourceAttr("fivetran_connector.test_connector", "config.sync_mode", "ManagerAccounts"),
+			resource.TestCheckResourceAttr("fivetran_connector.test_connector", "config.conversion_window_size", "60"),
+			resource.TestCheckResourceAttr("fivetran_connector.test_connector", "config.timeframe_months", "SIX"),
 			resource.TestCheckResourceAttr("fivetran_connector.test_connector", "config.reports.0.report_type", "report_1"),
-			resource.TestCheckResourceAttr("fivetran_connector.test_connector", "config.reports.0.metrics.0", "metric1"),
-			resource.TestCheckResourceAttr("fivetran_connector.test_connector", "config.reports.0.metrics.1", "metric2"),
+			resource.TestCheckResourceAttr("fivetran_connector.test_connector", "config.reports.0.fields.0", "metric1"),
+			resource.TestCheckResourceAttr("fivetran_connector.test_connector", "config.reports.0.fields.1", "metric2"),
 		),
 	}
 
@@ -197,14 +198,14 @@ func TestResourceConnectorMock(t *testing.T) {
 
 						if config, ok := tfmock.AssertKeyExists(t, body, "config").(map[string]interface{}); ok {
 							tfmock.AssertKeyExistsAndHasValue(t, config, "schema", "adwords_schema")
-							tfmock.AssertKeyExistsAndHasValue(t, config, "user", "user_name")
-							tfmock.AssertKeyExistsAndHasValue(t, config, "password", "password")
-							tfmock.AssertKeyExistsAndHasValue(t, config, "port", float64(5432))
+							tfmock.AssertKeyExistsAndHasValue(t, config, "customer_id", "customer_id")
+							tfmock.AssertKeyExistsAndHasValue(t, config, "sync_mode", "SpecificAccounts")
+							tfmock.AssertKeyExistsAndHasValue(t, config, "conversion_window_size", float64(30))
 							if reports, ok := tfmock.AssertKeyExists(t, config, "reports").([]interface{}); ok {
 								tfmock.AssertEqual(t, len(reports), 2)
 							}
-							if accountIds, ok := tfmock.AssertKeyExists(t, config, "account_ids").([]interface{}); ok {
-								tfmock.AssertEqual(t, len(accountIds), 3)
+							if accounts, ok := tfmock.AssertKeyExists(t, config, "accounts").([]interface{}); ok {
+								tfmock.AssertEqual(t, len(accounts), 3)
 							}
 						}
 
@@ -214,21 +215,21 @@ func TestResourceConnectorMock(t *testing.T) {
 							"google_ads",
 							"adwords_schema",
 							`{
-								"user": "user_name",
-								"password": "******",
-								"port": 5432,
-								"always_encrypted": true,
-								"account_ids": ["id1", "id2", "id3"],
+								"customer_id": "customer_id",
+								"sync_mode": "SpecificAccounts",
+								"conversion_window_size": 30,
+								"timeframe_months": "TWELVE",
+								"accounts": ["id1", "id2", "id3"],
 								"reports": [
 									{
 										"table": "table1",
 										"report_type": "report_1",
-										"metrics": ["metric1", "metric2"]
+										"fields": ["metric1", "metric2"]
 									},
 									{
 										"table": "table2",
 										"report_type": "report_2",
-										"metrics": ["metric2", "metric3"]
+										"fields": ["metric2", "metric3"]
 									}
 								]
 							}`,
@@ -252,10 +253,10 @@ func TestResourceConnectorMock(t *testing.T) {
 						tfmock.AssertKeyExistsAndHasValue(t, body, "trust_fingerprints", true)
 
 						if config, ok := tfmock.AssertKeyExists(t, body, "config").(map[string]interface{}); ok {
-							tfmock.AssertKeyExistsAndHasValue(t, config, "account_ids", nil)
-							tfmock.AssertKeyExistsAndHasValue(t, config, "user", "user_name_1")
-							tfmock.AssertKeyExistsAndHasValue(t, config, "password", "password_1")
-							tfmock.AssertKeyExistsAndHasValue(t, config, "port", float64(2345))
+							tfmock.AssertKeyExistsAndHasValue(t, config, "accounts", nil)
+							tfmock.AssertKeyExistsAndHasValue(t, config, "customer_id", "customer_id_1")
+							tfmock.AssertKeyExistsAndHasValue(t, config, "sync_mode", "ManagerAccounts")
+							tfmock.AssertKeyExistsAndHasValue(t, config, "conversion_window_size", float64(60))
 							if reports, ok := tfmock.AssertKeyExists(t, config, "reports").([]interface{}); ok {
 								tfmock.AssertEqual(t, len(reports), 1)
 							}
@@ -267,15 +268,15 @@ func TestResourceConnectorMock(t *testing.T) {
 							"google_ads",
 							"adwords_schema",
 							`{
-								"user": "user_name_1",
-								"password": "******",
-								"port": 2345,
-								"always_encrypted": false,
+								"customer_id": "customer_id_1",
+								"sync_mode": "ManagerAccounts",
+								"conversion_window_size": 60,
+								"timeframe_months": "SIX",
 								"reports": [
 									{
 										"table": "table1",
 										"report_type": "report_1",
-										"metrics": ["metric1", "metric2"]
+										"fields": ["metric1", "metric2"]
 									}
 								]
 							}`,
@@ -594,7 +595,7 @@ func TestConnectorNonNullableFieldNotConfiguredMock(t *testing.T) {
 
 func TestConnectorConfigCollectionSubFieldsUpdateMock(t *testing.T) {
 	testConnectorCreateUpdate(t,
-		"google_ads",
+		"google_search_ads_360",
 		`name = "schema_name"`,
 		`
 		reports {
@@ -667,6 +668,89 @@ func TestConnectorConfigCollectionSubFieldsUpdateMock(t *testing.T) {
 	)
 }
 
+func TestConnectorConfigCollectionKeyedItemsReorderMock(t *testing.T) {
+	testConnectorCreateUpdate(t,
+		"google_ads",
+		`name = "schema_name"`,
+		`
+		customer_id = "customer_id_1"
+		reports {
+			table = "table1"
+			report_type = "report_1"
+			fields = ["metric1", "metric2"]
+		}
+		reports {
+			table = "table2"
+			report_type = "report_2"
+			fields = ["metric2", "metric3"]
+		}
+		`,
+		`
+		customer_id = "customer_id_2"
+		reports {
+			table = "table2"
+			report_type = "report_2"
+			fields = ["metric2", "metric3"]
+		}
+		reports {
+			table = "table1"
+			report_type = "report_1"
+			fields = ["metric1", "metric2"]
+		}
+		`,
+		"schema_name",
+		`{
+			"customer_id": "customer_id_1",
+			"reports": [
+				{
+					"table": "table1",
+					"report_type": "report_1",
+					"fields": ["metric1", "metric2"]
+				},
+				{
+					"table": "table2",
+					"report_type": "report_2",
+					"fields": ["metric2", "metric3"]
+				}
+			]
+		}`,
+		`{
+			"customer_id": "customer_id_2",
+			"reports": [
+				{
+					"table": "table1",
+					"report_type": "report_1",
+					"fields": ["metric1", "metric2"]
+				},
+				{
+					"table": "table2",
+					"report_type": "report_2",
+					"fields": ["metric2", "metric3"]
+				}
+			]
+		}`,
+		nil,
+		func(t *testing.T, body map[string]interface{}) {
+			if config, ok := tfmock.AssertKeyExists(t, body, "config").(map[string]interface{}); ok {
+				tfmock.AssertKeyExistsAndHasValue(t, config, "customer_id", "customer_id_2")
+				// reports are matched by `table` key field, reordering is not a change
+				tfmock.AssertKeyDoesNotExist(t, config, "reports")
+			}
+		},
+		resource.ComposeAggregateTestCheckFunc(
+			resource.TestCheckResourceAttr("fivetran_connector.test_connector", "config.reports.#", "2"),
+			resource.TestCheckResourceAttr("fivetran_connector.test_connector", "config.reports.0.table", "table1"),
+		),
+		resource.ComposeAggregateTestCheckFunc(
+			resource.TestCheckResourceAttr("fivetran_connector.test_connector", "config.customer_id", "customer_id_2"),
+			resource.TestCheckResourceAttr("fivetran_connector.test_connector", "config.reports.#", "2"),
+			// reports are read in the configured order, the upstream order is ignored
+			resource.TestCheckResourceAttr("fivetran_connector.test_connector", "config.reports.0.table", "table2"),
+			resource.TestCheckResourceAttr("fivetran_connector.test_connector", "config.reports.1.table", "table1"),
+		),
+	)
+}
+
 func TestConnectorConfigCollectionSingleFieldObjectsMock(t *testing.T) {
 	testConnectorCreateUpdate(t,
 		"reddit_ads",
@@ -715,3 +799,505 @@ func TestConnectorConfigCollectionSingleFieldObjectsMock(t *testing.T) {
 		nil,
 	)
 }
+
+func TestConnectorConfigValidationMock(t *testing.T) {
+	resourceConfigTemplate := `
+	resource "fivetran_connector" "test_connector" {
+		provider = fivetran-provider
+
+		group_id = "group_id"
+		service  = "%v"
+
+		destination_schema {
+			name = "schema_name"
+		}
+
+		config {
+			%v
+		}
+	}`
+
+	resource.Test(
+		t,
+		resource.TestCase{
+			PreCheck: func() {
+				tfmock.MockClient().Reset()
+			},
+			ProtoV6ProviderFactories: tfmock.ProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config:      fmt.Sprintf(resourceConfigTemplate, "google_analytics", `port = 123`),
+					PlanOnly:    true,
+					ExpectError: regexp.MustCompile("Field `port` isn't supported for service\\s+`google_analytics`"),
+				},
+				{
+					Config:      fmt.Sprintf(resourceConfigTemplate, "reddit_ads", `accounts = ["account"]`),
+					PlanOnly:    true,
+					ExpectError: regexp.MustCompile("try use\\s+`accounts_reddit_ads`\\s+instead"),
+				},
+				{
+					Config:      fmt.Sprintf(resourceConfigTemplate, "yahoo_gemini", `advertisers_id = ["advertiser"]`),
+					PlanOnly:    true,
+					ExpectError: regexp.MustCompile("Value `advertiser` should be an integer for service\\s+`yahoo_gemini`"),
+				},
+				{
+					Config: fmt.Sprintf(resourceConfigTemplate, "google_analytics", `
+					reports {
+						table = "table"
+						fields = ["field"]
+					}`),
+					PlanOnly:    true,
+					ExpectError: regexp.MustCompile("Field `fields` isn't supported for service\\s+`google_analytics`"),
+				},
+				{
+					Config:      fmt.Sprintf(resourceConfigTemplate, "unknown_service", `port = 123`),
+					PlanOnly:    true,
+					ExpectError: regexp.MustCompile("Service `unknown_service` isn't supported by the provider"),
+				},
+			},
+		},
+	)
+}
+
+func TestConnectorRemovedOutOfBandMock(t *testing.T) {
+	config := `
+	resource "fivetran_connector" "test_connector" {
+		provider = fivetran-provider
+
+		group_id           = "group_id"
+		service            = "amplitude"
+		run_setup_tests    = false
+		trust_fingerprints = false
+		trust_certificates = false
+
+		destination_schema {
+			name = "schema_name"
+		}
+	}`
+
+	var responseData map[string]interface{}
+
+	preCheck := func() {
+		tfmock.MockClient().Reset()
+
+		tfmock.MockClient().When(http.MethodGet, "/v1/connectors/connector_id").ThenCall(
+			func(req *http.Request) (*http.Response, error) {
+				if responseData == nil {
+					return tfmock.FivetranSuccessResponse(t, req, http.StatusNotFound, "NotFound_Connector", nil), nil
+				}
+				return tfmock.FivetranSuccessResponse(t, req, http.StatusOK, "Success", responseData), nil
+			},
+		)
+
+		tfmock.MockClient().When(http.MethodDelete, "/v1/connectors/connector_id").ThenCall(
+			func(req *http.Request) (*http.Response, error) {
+				responseData = nil
+				return tfmock.FivetranSuccessResponse(t, req, http.StatusOK, "Success", nil), nil
+			},
+		)
+
+		tfmock.MockClient().When(http.MethodPost, "/v1/connectors").ThenCall(
+			func(req *http.Request) (*http.Response, error) {
+				responseJson := createConnectorTestResponseJsonMock(
+					"connector_id",
+					"group_id",
+					"amplitude",
+					"schema_name",
+					`{}`,
+				)
+				responseData = tfmock.CreateMapFromJsonString(t, responseJson)
+				return tfmock.FivetranSuccessResponse(t, req, http.StatusCreated, "Success", responseData), nil
+			},
+		)
+	}
+
+	resource.Test(
+		t,
+		resource.TestCase{
+			PreCheck:                 preCheck,
+			ProtoV6ProviderFactories: tfmock.ProtoV6ProviderFactories,
+			CheckDestroy: func(s *terraform.State) error {
+				return nil
+			},
+			Steps: []resource.TestStep{
+				{
+					Config: config,
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("fivetran_connector.test_connector", "id", "connector_id"),
+					),
+				},
+				{
+					// connector deleted outside of Terraform should be planned for re-creation
+					PreConfig: func() {
+						responseData = nil
+					},
+					Config:             config,
+					PlanOnly:           true,
+					ExpectNonEmptyPlan: true,
+				},
+			},
+		},
+	)
+}
+
+func TestConnectorWaitForSetupMock(t *testing.T) {
+	config := `
+	resource "fivetran_connector" "test_connector" {
+		provider = fivetran-provider
+
+		group_id           = "group_id"
+		service            = "amplitude"
+		run_setup_tests    = true
+		wait_for_setup     = true
+
+		destination_schema {
+			name = "schema_name"
+		}
+	}`
+
+	var responseData map[string]interface{}
+	var setupState string
+
+	preCheck := func(state string) func() {
+		return func() {
+			tfmock.MockClient().Reset()
+			responseData = nil
+			setupState = state
+
+			tfmock.MockClient().When(http.MethodGet, "/v1/connectors/connector_id").ThenCall(
+				func(req *http.Request) (*http.Response, error) {
+					if responseData == nil {
+						return tfmock.FivetranSuccessResponse(t, req, http.StatusNotFound, "NotFound_Connector", nil), nil
+					}
+					responseData["status"].(map[string]interface{})["setup_state"] = setupState
+					return tfmock.FivetranSuccessResponse(t, req, http.StatusOK, "Success", responseData), nil
+				},
+			)
+
+			tfmock.MockClient().When(http.MethodDelete, "/v1/connectors/connector_id").ThenCall(
+				func(req *http.Request) (*http.Response, error) {
+					responseData = nil
+					return tfmock.FivetranSuccessResponse(t, req, http.StatusOK, "Success", nil), nil
+				},
+			)
+
+			tfmock.MockClient().When(http.MethodPost, "/v1/connectors").ThenCall(
+				func(req *http.Request) (*http.Response, error) {
+					responseJson := createConnectorTestResponseJsonMock(
+						"connector_id",
+						"group_id",
+						"amplitude",
+						"schema_name",
+						`{}`,
+					)
+					responseData = tfmock.CreateMapFromJsonString(t, responseJson)
+					return tfmock.FivetranSuccessResponse(t, req, http.StatusCreated, "Success", responseData), nil
+				},
+			)
+		}
+	}
+
+	resource.Test(
+		t,
+		resource.TestCase{
+			PreCheck:                 preCheck("connected"),
+			ProtoV6ProviderFactories: tfmock.ProtoV6ProviderFactories,
+			CheckDestroy: func(s *terraform.State) error {
+				return nil
+			},
+			Steps: []resource.TestStep{
+				{
+					Config: config,
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("fivetran_connector.test_connector", "id", "connector_id"),
+						resource.TestCheckResourceAttr("fivetran_connector.test_connector", "wait_for_setup", "true"),
+					),
+				},
+			},
+		},
+	)
+
+	resource.Test(
+		t,
+		resource.TestCase{
+			PreCheck:                 preCheck("broken"),
+			ProtoV6ProviderFactories: tfmock.ProtoV6ProviderFactories,
+			CheckDestroy: func(s *terraform.State) error {
+				return nil
+			},
+			Steps: []resource.TestStep{
+				{
+					Config:      config,
+					ExpectError: regexp.MustCompile(`setup state of connector connector_id is broken\s+- task: reconnect: Reconnect`),
+				},
+			},
+		},
+	)
+}
+
+func TestConnectorConfigJsonMock(t *testing.T) {
+	resourceConfigTemplate := `
+	resource "fivetran_connector" "test_connector" {
+		provider = fivetran-provider
+
+		group_id = "group_id"
+		service  = "google_ads"
+
+		destination_schema {
+			name = "schema_name"
+		}
+
+		config {
+			sync_mode = "AllAccounts"
+		}
+
+		config_json = jsonencode({
+			new_field = "%v"
+		})
+	}`
+
+	var responseData map[string]interface{}
+	var postBody, patchBody map[string]interface{}
+
+	respond := func(t *testing.T, req *http.Request, status int, newField string) *http.Response {
+		responseJson := createConnectorTestResponseJsonMock(
+			"connector_id",
+			"group_id",
+			"google_ads",
+			"schema_name",
+			fmt.Sprintf(`{"sync_mode": "AllAccounts", "new_field": "%v"}`, newField),
+		)
+		responseData = tfmock.CreateMapFromJsonString(t, responseJson)
+		return tfmock.FivetranSuccessResponse(t, req, status, "Success", responseData)
+	}
+
+	preCheck := func() {
+		tfmock.MockClient().Reset()
+		responseData = nil
+
+		tfmock.MockClient().When(http.MethodGet, "/v1/connectors/connector_id").ThenCall(
+			func(req *http.Request) (*http.Response, error) {
+				if responseData == nil {
+					return tfmock.FivetranSuccessResponse(t, req, http.StatusNotFound, "NotFound", nil), nil
+				}
+				return tfmock.FivetranSuccessResponse(t, req, http.StatusOK, "Success", responseData), nil
+			},
+		)
+
+		tfmock.MockClient().When(http.MethodDelete, "/v1/connectors/connector_id").ThenCall(
+			func(req *http.Request) (*http.Response, error) {
+				responseData = nil
+				return tfmock.FivetranSuccessResponse(t, req, http.StatusOK, "Success", nil), nil
+			},
+		)
+
+		tfmock.MockClient().When(http.MethodPost, "/v1/connectors").ThenCall(
+			func(req *http.Request) (*http.Response, error) {
+				postBody = tfmock.RequestBodyToJson(t, req)
+				return respond(t, req, http.StatusCreated, "value1"), nil
+			},
+		)
+
+		tfmock.MockClient().When(http.MethodPatch, "/v1/connectors/connector_id").ThenCall(
+			func(req *http.Request) (*http.Response, error) {
+				patchBody = tfmock.RequestBodyToJson(t, req)
+				return respond(t, req, http.StatusOK, "value2"), nil
+			},
+		)
+	}
+
+	resource.Test(
+		t,
+		resource.TestCase{
+			PreCheck:                 preCheck,
+			ProtoV6ProviderFactories: tfmock.ProtoV6ProviderFactories,
+			CheckDestroy: func(s *terraform.State) error {
+				return nil
+			},
+			Steps: []resource.TestStep{
+				{
+					Config: fmt.Sprintf(resourceConfigTemplate, "value1"),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						func(s *terraform.State) error {
+							config := tfmock.AssertKeyExists(t, postBody, "config").(map[string]interface{})
+							tfmock.AssertKeyExistsAndHasValue(t, config, "sync_mode", "AllAccounts")
+							tfmock.AssertKeyExistsAndHasValue(t, config, "new_field", "value1")
+							tfmock.AssertKeyExistsAndHasValue(t, config, "schema", "schema_name")
+							return nil
+						},
+						resource.TestCheckResourceAttr("fivetran_connector.test_connector", "config.sync_mode", "AllAccounts"),
+						resource.TestCheckResourceAttr("fivetran_connector.test_connector", "config_json", `{"new_field":"value1"}`),
+					),
+				},
+				{
+					Config: fmt.Sprintf(resourceConfigTemplate, "value2"),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						func(s *terraform.State) error {
+							config := tfmock.AssertKeyExists(t, patchBody, "config").(map[string]interface{})
+							tfmock.AssertKeyDoesNotExist(t, config, "sync_mode")
+							tfmock.AssertKeyExistsAndHasValue(t, config, "new_field", "value2")
+							return nil
+						},
+						resource.TestCheckResourceAttr("fivetran_connector.test_connector", "config_json", `{"new_field":"value2"}`),
+					),
+				},
+			},
+		},
+	)
+}
+
+func TestConnectorConfigJsonValidationMock(t *testing.T) {
+	resourceConfigTemplate := `
+	resource "fivetran_connector" "test_connector" {
+		provider = fivetran-provider
+
+		group_id = "group_id"
+		service  = "reddit_ads"
+
+		destination_schema {
+			name = "schema_name"
+		}
+
+		config {
+			accounts_reddit_ads {
+				name = "acc1"
+			}
+		}
+
+		config_json = %v
+	}`
+
+	resource.Test(
+		t,
+		resource.TestCase{
+			PreCheck: func() {
+				tfmock.MockClient().Reset()
+			},
+			ProtoV6ProviderFactories: tfmock.ProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config:      fmt.Sprintf(resourceConfigTemplate, `"[1, 2]"`),
+					PlanOnly:    true,
+					ExpectError: regexp.MustCompile("The value should be a JSON object"),
+				},
+				{
+					Config:      fmt.Sprintf(resourceConfigTemplate, `jsonencode({ accounts = [{ name = "acc2" }] })`),
+					PlanOnly:    true,
+					ExpectError: regexp.MustCompile("Field `accounts_reddit_ads` can't be set in both `config` and\\s+`config_json`"),
+				},
+			},
+		},
+	)
+}
+
+func TestConnectorCredentialsVersionMock(t *testing.T) {
+	resourceConfigTemplate := `
+	resource "fivetran_connector" "test_connector" {
+		provider = fivetran-provider
+
+		group_id            = "group_id"
+		service             = "amplitude"
+		credentials_version = %v
+
+		destination_schema {
+			name = "schema_name"
+		}
+
+		config {
+			project_credentials {
+				project    = "project_name"
+				api_key    = "api_key"
+				secret_key = "secret_key"
+			}
+		}
+	}`
+
+	var responseData map[string]interface{}
+	var patchHandler *mock.Handler
+	var patchBody map[string]interface{}
+
+	preCheck := func() {
+		tfmock.MockClient().Reset()
+		responseData = nil
+
+		tfmock.MockClient().When(http.MethodGet, "/v1/connectors/connector_id").ThenCall(
+			func(req *http.Request) (*http.Response, error) {
+				if responseData == nil {
+					return tfmock.FivetranSuccessResponse(t, req, http.StatusNotFound, "NotFound", nil), nil
+				}
+				return tfmock.FivetranSuccessResponse(t, req, http.StatusOK, "Success", responseData), nil
+			},
+		)
+
+		tfmock.MockClient().When(http.MethodDelete, "/v1/connectors/connector_id").ThenCall(
+			func(req *http.Request) (*http.Response, error) {
+				responseData = nil
+				return tfmock.FivetranSuccessResponse(t, req, http.StatusOK, "Success", nil), nil
+			},
+		)
+
+		tfmock.MockClient().When(http.MethodPost, "/v1/connectors").ThenCall(
+			func(req *http.Request) (*http.Response, error) {
+				responseJson := createConnectorTestResponseJsonMock(
+					"connector_id",
+					"group_id",
+					"amplitude",
+					"schema_name",
+					`{
+						"project_credentials": [
+							{
+								"project": "project_name",
+								"api_key": "******",
+								"secret_key": "******"
+							}
+						]
+					}`,
+				)
+				responseData = tfmock.CreateMapFromJsonString(t, responseJson)
+				return tfmock.FivetranSuccessResponse(t, req, http.StatusCreated, "Success", responseData), nil
+			},
+		)
+
+		patchHandler = tfmock.MockClient().When(http.MethodPatch, "/v1/connectors/connector_id").ThenCall(
+			func(req *http.Request) (*http.Response, error) {
+				patchBody = tfmock.RequestBodyToJson(t, req)
+				return tfmock.FivetranSuccessResponse(t, req, http.StatusOK, "Success", responseData), nil
+			},
+		)
+	}
+
+	resource.Test(
+		t,
+		resource.TestCase{
+			PreCheck:                 preCheck,
+			ProtoV6ProviderFactories: tfmock.ProtoV6ProviderFactories,
+			CheckDestroy: func(s *terraform.State) error {
+				return nil
+			},
+			Steps: []resource.TestStep{
+				{
+					Config: fmt.Sprintf(resourceConfigTemplate, 1),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("fivetran_connector.test_connector", "credentials_version", "1"),
+					),
+				},
+				{
+					Config: fmt.Sprintf(resourceConfigTemplate, 2),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						func(s *terraform.State) error {
+							tfmock.AssertEqual(t, patchHandler.Interactions, 1)
+							// sensitive fields are sent again though they are not changed in the configuration
+							config := tfmock.AssertKeyExists(t, patchBody, "config").(map[string]interface{})
+							credentials := tfmock.AssertKeyExists(t, config, "project_credentials").([]interface{})
+							tfmock.AssertEqual(t, len(credentials), 1)
+							tfmock.AssertKeyExistsAndHasValue(t, credentials[0].(map[string]interface{}), "api_key", "api_key")
+							tfmock.AssertKeyExistsAndHasValue(t, credentials[0].(map[string]interface{}), "secret_key", "secret_key")
+							return nil
+						},
+						resource.TestCheckResourceAttr("fivetran_connector.test_connector", "credentials_version", "2"),
+					),
+				},
+			},
+		},
+	)
+}
@@ -0,0 +1,397 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/fivetran/go-fivetran"
+	"github.com/fivetran/go-fivetran/dbt"
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core"
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core/model"
+	fivetranSchema "github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core/schema"
+	"github.com/fivetran/terraform-provider-fivetran/modules/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	dbtProjectDefaultTimeout = 20 * time.Minute
+	dbtProjectPollInterval   = 10 * time.Second
+)
+
+func DbtProject() resource.Resource {
+	return &dbtProject{}
+}
+
+type dbtProject struct {
+	core.ProviderResource
+}
+
+// Ensure the implementation satisfies the desired interfaces.
+var _ resource.ResourceWithConfigure = &dbtProject{}
+var _ resource.ResourceWithImportState = &dbtProject{}
+var _ resource.ResourceWithUpgradeState = &dbtProject{}
+
+func (r *dbtProject) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dbt_project"
+}
+
+func (r *dbtProject) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = fivetranSchema.DbtProjectResource(ctx)
+	resp.Schema.Version = 1
+}
+
+func (r *dbtProject) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		// State upgrade implementation from 0 (SDKv2 resource state) to 1 (Schema.Version)
+		0: {
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				upgradeSdkState(ctx, req, resp, getDbtProjectStateModel(), nil, "target_name", "environment_vars")
+			},
+		},
+	}
+}
+
+func (r *dbtProject) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *dbtProject) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.GetClient() == nil {
+		resp.Diagnostics.AddError(
+			"Unconfigured Fivetran Client",
+			"Please report this issue to the provider developers.",
+		)
+
+		return
+	}
+
+	var data model.DbtProjectResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, dbtProjectDefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helpers.SetContextTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// If project type is not defined we consider project type = "GIT" on API side
+	projectType := "GIT"
+	if !data.Type.IsNull() && !data.Type.IsUnknown() {
+		projectType = data.Type.ValueString()
+	}
+	if projectType != "GIT" {
+		resp.Diagnostics.AddError(
+			"Unable to Create dbt Project Resource.",
+			"Able to create only a project of type GIT",
+		)
+		return
+	}
+
+	projectConfig := data.GetProjectConfig(ctx)
+	// Currently git_remote_url is required: only GIT project could be managed via API
+	if projectConfig.GitRemoteUrl.IsNull() || projectConfig.GitRemoteUrl.IsUnknown() {
+		resp.Diagnostics.AddError(
+			"Unable to Create dbt Project Resource.",
+			"git_remote_url is required for project of type GIT",
+		)
+		return
+	}
+
+	configRequest := fivetran.NewDbtProjectConfig().GitRemoteUrl(projectConfig.GitRemoteUrl.ValueString())
+	if !projectConfig.GitBranch.IsNull() && !projectConfig.GitBranch.IsUnknown() {
+		configRequest.GitBranch(projectConfig.GitBranch.ValueString())
+	}
+	if !projectConfig.FolderPath.IsNull() && !projectConfig.FolderPath.IsUnknown() {
+		configRequest.FolderPath(projectConfig.FolderPath.ValueString())
+	}
+
+	svc := r.GetClient().NewDbtProjectCreate()
+	svc.GroupID(data.GroupId.ValueString())
+	svc.DbtVersion(data.DbtVersion.ValueString())
+	svc.DefaultSchema(data.DefaultSchema.ValueString())
+	svc.Type(projectType)
+	svc.ProjectConfig(configRequest)
+
+	if envVars := data.GetEnvironmentVars(); len(envVars) > 0 {
+		svc.EnvironmentVars(envVars)
+	}
+	if !data.TargetName.IsNull() && !data.TargetName.IsUnknown() {
+		svc.TargetName(data.TargetName.ValueString())
+	}
+	if !data.Threads.IsNull() && !data.Threads.IsUnknown() {
+		svc.Threads(int(data.Threads.ValueInt64()))
+	}
+
+	createResponse, err := svc.Do(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create dbt Project Resource.",
+			fmt.Sprintf("%v; code: %v; message: %v", err, createResponse.Code, createResponse.Message),
+		)
+		return
+	}
+
+	projectId := createResponse.Data.ID
+	ensureReadiness := core.GetBoolOrDefault(data.EnsureReadiness, true)
+
+	if ensureReadiness && strings.ToLower(createResponse.Data.Status) != "ready" {
+		if err := waitForDbtProjectReadiness(ctx, r.GetClient(), projectId); err != nil {
+			// the project can't be used, cleanup to let the next apply re-create it
+			deleteResponse, deleteErr := r.GetClient().NewDbtProjectDelete().DbtProjectID(projectId).Do(context.Background())
+			if deleteErr != nil {
+				resp.Diagnostics.AddError(
+					"Unable to Create dbt Project Resource.",
+					fmt.Sprintf("%v; failed to cleanup after unsuccessful creation: %v; code: %v; message: %v", err, deleteErr, deleteResponse.Code, deleteResponse.Message),
+				)
+				return
+			}
+			resp.Diagnostics.AddError(
+				"Unable to Create dbt Project Resource.",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	projectResponse, err := r.GetClient().NewDbtProjectDetails().DbtProjectID(projectId).Do(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read dbt Project Resource after creation.",
+			fmt.Sprintf("%v; code: %v; message: %v", err, projectResponse.Code, projectResponse.Message),
+		)
+		return
+	}
+
+	data.ReadFromResponse(projectResponse)
+	data.EnsureReadiness = types.BoolValue(ensureReadiness)
+
+	if strings.ToLower(projectResponse.Data.Status) == "ready" {
+		modelsResponse, err := dbtModelsForProject(ctx, r.GetClient(), projectId)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Read dbt Project Resource after creation.",
+				fmt.Sprintf("%v; code: %v; message: %v", err, modelsResponse.Code, modelsResponse.Message),
+			)
+			return
+		}
+		data.ReadModelsFromResponse(modelsResponse)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *dbtProject) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if r.GetClient() == nil {
+		resp.Diagnostics.AddError(
+			"Unconfigured Fivetran Client",
+			"Please report this issue to the provider developers.",
+		)
+
+		return
+	}
+
+	var data model.DbtProjectResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	projectResponse, err := r.GetClient().NewDbtProjectDetails().DbtProjectID(data.Id.ValueString()).Do(ctx)
+
+	if err != nil {
+		// If the resource does not exist (404), inform Terraform.
+		if helpers.IsNotFound(projectResponse.Code, err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Unable to Read dbt Project Resource.",
+			fmt.Sprintf("%v; code: %v; message: %v", err, projectResponse.Code, projectResponse.Message),
+		)
+		return
+	}
+
+	data.ReadFromResponse(projectResponse)
+
+	if strings.ToLower(projectResponse.Data.Status) == "ready" {
+		modelsResponse, err := dbtModelsForProject(ctx, r.GetClient(), projectResponse.Data.ID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Read dbt Project Resource.",
+				fmt.Sprintf("%v; code: %v; message: %v", err, modelsResponse.Code, modelsResponse.Message),
+			)
+			return
+		}
+		data.ReadModelsFromResponse(modelsResponse)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *dbtProject) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.GetClient() == nil {
+		resp.Diagnostics.AddError(
+			"Unconfigured Fivetran Client",
+			"Please report this issue to the provider developers.",
+		)
+
+		return
+	}
+
+	var plan, state model.DbtProjectResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	svc := r.GetClient().NewDbtProjectModify().DbtProjectID(state.Id.ValueString())
+
+	if !plan.DbtVersion.Equal(state.DbtVersion) {
+		svc.DbtVersion(plan.DbtVersion.ValueString())
+	}
+	if !plan.TargetName.Equal(state.TargetName) {
+		svc.TargetName(plan.TargetName.ValueString())
+	}
+	if !plan.Threads.IsUnknown() && !plan.Threads.Equal(state.Threads) {
+		svc.Threads(int(plan.Threads.ValueInt64()))
+	}
+	if !plan.EnvironmentVars.Equal(state.EnvironmentVars) {
+		svc.EnvironmentVars(plan.GetEnvironmentVars())
+	}
+
+	planConfig := plan.GetProjectConfig(ctx)
+	stateConfig := state.GetProjectConfig(ctx)
+	gitBranchChanged := !planConfig.GitBranch.IsUnknown() && !planConfig.GitBranch.Equal(stateConfig.GitBranch)
+	folderPathChanged := !planConfig.FolderPath.IsUnknown() && !planConfig.FolderPath.Equal(stateConfig.FolderPath)
+	if gitBranchChanged || folderPathChanged {
+		configRequest := fivetran.NewDbtProjectConfig()
+		if gitBranchChanged {
+			configRequest.GitBranch(planConfig.GitBranch.ValueString())
+		}
+		if folderPathChanged {
+			configRequest.FolderPath(planConfig.FolderPath.ValueString())
+		}
+		svc.ProjectConfig(configRequest)
+	}
+
+	updateResponse, err := svc.Do(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Update dbt Project Resource.",
+			fmt.Sprintf("%v; code: %v; message: %v", err, updateResponse.Code, updateResponse.Message),
+		)
+		return
+	}
+
+	projectResponse, err := r.GetClient().NewDbtProjectDetails().DbtProjectID(state.Id.ValueString()).Do(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read dbt Project Resource after update.",
+			fmt.Sprintf("%v; code: %v; message: %v", err, projectResponse.Code, projectResponse.Message),
+		)
+		return
+	}
+
+	plan.ReadFromResponse(projectResponse)
+	plan.EnsureReadiness = types.BoolValue(core.GetBoolOrDefault(plan.EnsureReadiness, true))
+
+	if strings.ToLower(projectResponse.Data.Status) == "ready" {
+		modelsResponse, err := dbtModelsForProject(ctx, r.GetClient(), projectResponse.Data.ID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Read dbt Project Resource after update.",
+				fmt.Sprintf("%v; code: %v; message: %v", err, modelsResponse.Code, modelsResponse.Message),
+			)
+			return
+		}
+		plan.ReadModelsFromResponse(modelsResponse)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *dbtProject) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.GetClient() == nil {
+		resp.Diagnostics.AddError(
+			"Unconfigured Fivetran Client",
+			"Please report this issue to the provider developers.",
+		)
+
+		return
+	}
+
+	var data model.DbtProjectResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	deleteResponse, err := r.GetClient().NewDbtProjectDelete().DbtProjectID(data.Id.ValueString()).Do(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Delete dbt Project Resource.",
+			fmt.Sprintf("%v; code: %v; message: %v", err, deleteResponse.Code, deleteResponse.Message),
+		)
+		return
+	}
+}
+
+// waitForDbtProjectReadiness polls the project status until the project leaves NOT_READY status
+func waitForDbtProjectReadiness(ctx context.Context, client *fivetran.Client, projectId string) error {
+	for {
+		projectResponse, err := client.NewDbtProjectDetails().DbtProjectID(projectId).Do(ctx)
+		if err != nil {
+			return fmt.Errorf("unable to get status for dbt project: %v error: %v", projectId, err)
+		}
+		switch strings.ToLower(projectResponse.Data.Status) {
+		case "ready":
+			return nil
+		case "not_ready":
+		default:
+			return fmt.Errorf("dbt project: %v has \"ERROR\" status after creation; errors: %v;", projectId, projectResponse.Data.Errors)
+		}
+		if dl, ok := ctx.Deadline(); ok && time.Now().After(dl.Add(-2*dbtProjectPollInterval)) {
+			// deadline will be exceeded on next iteration
+			return fmt.Errorf("project %v is stuck in \"NOT_READY\" status", projectId)
+		}
+		helpers.ContextDelay(ctx, dbtProjectPollInterval)
+	}
+}
+
+// dbtModelsForProject returns all models of the dbt project, it handles limits and cursors
+func dbtModelsForProject(ctx context.Context, client *fivetran.Client, projectId string) (dbt.DbtModelsListResponse, error) {
+	var result dbt.DbtModelsListResponse
+	var cursor string
+
+	for {
+		svc := client.NewDbtModelsList().ProjectId(projectId).Limit(1000)
+		if cursor != "" {
+			svc.Cursor(cursor)
+		}
+		resp, err := svc.Do(ctx)
+		if err != nil {
+			return resp, err
+		}
+
+		result.Data.Items = append(result.Data.Items, resp.Data.Items...)
+
+		if resp.Data.NextCursor == "" {
+			break
+		}
+		cursor = resp.Data.NextCursor
+	}
+
+	return result, nil
+}
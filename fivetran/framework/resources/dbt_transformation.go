@@ -0,0 +1,321 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/fivetran/go-fivetran"
+	"github.com/fivetran/go-fivetran/dbt"
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core"
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core/model"
+	fivetranSchema "github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core/schema"
+	"github.com/fivetran/terraform-provider-fivetran/modules/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+func DbtTransformation() resource.Resource {
+	return &dbtTransformation{}
+}
+
+type dbtTransformation struct {
+	core.ProviderResource
+}
+
+// Ensure the implementation satisfies the desired interfaces.
+var _ resource.ResourceWithConfigure = &dbtTransformation{}
+var _ resource.ResourceWithImportState = &dbtTransformation{}
+var _ resource.ResourceWithUpgradeState = &dbtTransformation{}
+
+func (r *dbtTransformation) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dbt_transformation"
+}
+
+func (r *dbtTransformation) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = fivetranSchema.DbtTransformationResource(ctx)
+	resp.Schema.Version = 1
+}
+
+func (r *dbtTransformation) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		// State upgrade implementation from 0 (SDKv2 resource state) to 1 (Schema.Version)
+		0: {
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				upgradeSdkState(ctx, req, resp, getDbtTransformationStateModel(), nil)
+			},
+		},
+	}
+}
+
+func (r *dbtTransformation) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *dbtTransformation) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.GetClient() == nil {
+		resp.Diagnostics.AddError(
+			"Unconfigured Fivetran Client",
+			"Please report this issue to the provider developers.",
+		)
+
+		return
+	}
+
+	var data model.DbtTransformationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, dbtProjectDefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helpers.SetContextTimeout(ctx, createTimeout)
+	defer cancel()
+
+	projectId := data.DbtProjectId.ValueString()
+	modelName := data.DbtModelName.ValueString()
+
+	if err := waitForDbtProjectReadiness(ctx, r.GetClient(), projectId); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create dbt Transformation Resource.",
+			err.Error(),
+		)
+		return
+	}
+
+	modelId, err := waitForDbtModel(ctx, r.GetClient(), projectId, modelName)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create dbt Transformation Resource.",
+			err.Error(),
+		)
+		return
+	}
+
+	schedule := data.GetSchedule(ctx)
+	scheduleRequest := fivetran.NewDbtTransformationSchedule()
+	scheduleRequest.ScheduleType(schedule.ScheduleType.ValueString())
+	if !schedule.DaysOfWeek.IsNull() && !schedule.DaysOfWeek.IsUnknown() {
+		scheduleRequest.DaysOfWeek(schedule.GetDaysOfWeek())
+	}
+	if !schedule.Interval.IsNull() && !schedule.Interval.IsUnknown() && schedule.Interval.ValueInt64() > 0 {
+		scheduleRequest.Interval(int(schedule.Interval.ValueInt64()))
+	}
+	if !schedule.TimeOfDay.IsNull() && !schedule.TimeOfDay.IsUnknown() {
+		scheduleRequest.TimeOfDay(schedule.TimeOfDay.ValueString())
+	}
+
+	svc := r.GetClient().NewDbtTransformationCreateService()
+	svc.DbtModelId(modelId)
+	svc.RunTests(data.RunTests.ValueBool())
+	svc.Paused(data.Paused.ValueBool())
+	svc.Schedule(scheduleRequest)
+
+	createResponse, err := svc.Do(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create dbt Transformation Resource.",
+			fmt.Sprintf("%v; code: %v; message: %v", err, createResponse.Code, createResponse.Message),
+		)
+		return
+	}
+
+	modelResponse, err := r.GetClient().NewDbtModelDetails().ModelId(createResponse.Data.DbtModelId).Do(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read dbt Transformation Resource after creation.",
+			fmt.Sprintf("%v; code: %v; message: %v", err, modelResponse.Code, modelResponse.Message),
+		)
+		return
+	}
+
+	data.ReadFromResponse(createResponse, modelResponse)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *dbtTransformation) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if r.GetClient() == nil {
+		resp.Diagnostics.AddError(
+			"Unconfigured Fivetran Client",
+			"Please report this issue to the provider developers.",
+		)
+
+		return
+	}
+
+	var data model.DbtTransformationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	transformationResponse, err := r.GetClient().NewDbtTransformationDetailsService().TransformationId(data.Id.ValueString()).Do(ctx)
+
+	if err != nil {
+		// If the resource does not exist (404), inform Terraform.
+		if helpers.IsNotFound(transformationResponse.Code, err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Unable to Read dbt Transformation Resource.",
+			fmt.Sprintf("%v; code: %v; message: %v", err, transformationResponse.Code, transformationResponse.Message),
+		)
+		return
+	}
+
+	modelResponse, err := r.GetClient().NewDbtModelDetails().ModelId(transformationResponse.Data.DbtModelId).Do(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read dbt Transformation Resource.",
+			fmt.Sprintf("%v; code: %v; message: %v", err, modelResponse.Code, modelResponse.Message),
+		)
+		return
+	}
+
+	data.ReadFromResponse(transformationResponse, modelResponse)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *dbtTransformation) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.GetClient() == nil {
+		resp.Diagnostics.AddError(
+			"Unconfigured Fivetran Client",
+			"Please report this issue to the provider developers.",
+		)
+
+		return
+	}
+
+	var plan, state model.DbtTransformationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	svc := r.GetClient().NewDbtTransformationModifyService().DbtTransformationId(state.Id.ValueString())
+
+	if !plan.RunTests.Equal(state.RunTests) {
+		svc.RunTests(plan.RunTests.ValueBool())
+	}
+	if !plan.Paused.Equal(state.Paused) {
+		svc.Paused(plan.Paused.ValueBool())
+	}
+
+	// values removed from the configuration are planned as unknown, the upstream keeps them as is
+	planSchedule := plan.GetSchedule(ctx)
+	stateSchedule := state.GetSchedule(ctx)
+	scheduleRequest := fivetran.NewDbtTransformationSchedule()
+	scheduleChanged := false
+	if !planSchedule.ScheduleType.Equal(stateSchedule.ScheduleType) {
+		scheduleRequest.ScheduleType(planSchedule.ScheduleType.ValueString())
+		scheduleChanged = true
+	}
+	if !planSchedule.DaysOfWeek.IsUnknown() && !planSchedule.DaysOfWeek.Equal(stateSchedule.DaysOfWeek) {
+		scheduleRequest.DaysOfWeek(planSchedule.GetDaysOfWeek())
+		scheduleChanged = true
+	}
+	if !planSchedule.Interval.IsUnknown() && !planSchedule.Interval.Equal(stateSchedule.Interval) {
+		scheduleRequest.Interval(int(planSchedule.Interval.ValueInt64()))
+		scheduleChanged = true
+	}
+	if !planSchedule.TimeOfDay.IsUnknown() && !planSchedule.TimeOfDay.Equal(stateSchedule.TimeOfDay) {
+		scheduleRequest.TimeOfDay(planSchedule.TimeOfDay.ValueString())
+		scheduleChanged = true
+	}
+	if scheduleChanged {
+		svc.Schedule(scheduleRequest)
+	}
+
+	updateResponse, err := svc.Do(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Update dbt Transformation Resource.",
+			fmt.Sprintf("%v; code: %v; message: %v", err, updateResponse.Code, updateResponse.Message),
+		)
+		return
+	}
+
+	transformationResponse, err := r.GetClient().NewDbtTransformationDetailsService().TransformationId(state.Id.ValueString()).Do(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read dbt Transformation Resource after update.",
+			fmt.Sprintf("%v; code: %v; message: %v", err, transformationResponse.Code, transformationResponse.Message),
+		)
+		return
+	}
+
+	modelResponse, err := r.GetClient().NewDbtModelDetails().ModelId(transformationResponse.Data.DbtModelId).Do(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read dbt Transformation Resource after update.",
+			fmt.Sprintf("%v; code: %v; message: %v", err, modelResponse.Code, modelResponse.Message),
+		)
+		return
+	}
+
+	plan.ReadFromResponse(transformationResponse, modelResponse)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *dbtTransformation) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.GetClient() == nil {
+		resp.Diagnostics.AddError(
+			"Unconfigured Fivetran Client",
+			"Please report this issue to the provider developers.",
+		)
+
+		return
+	}
+
+	var data model.DbtTransformationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	deleteResponse, err := r.GetClient().NewDbtTransformationDeleteService().TransformationId(data.Id.ValueString()).Do(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Delete dbt Transformation Resource.",
+			fmt.Sprintf("%v; code: %v; message: %v", err, deleteResponse.Code, deleteResponse.Message),
+		)
+		return
+	}
+}
+
+// waitForDbtModel polls the project models until the model with the given name appears
+func waitForDbtModel(ctx context.Context, client *fivetran.Client, projectId, modelName string) (string, error) {
+	for {
+		modelsResponse, err := dbtModelsForProject(ctx, client, projectId)
+		if err != nil {
+			return "", fmt.Errorf("%v; code: %v; message: %v", err, modelsResponse.Code, modelsResponse.Message)
+		}
+		if modelId, ok := findDbtModelId(modelsResponse, modelName); ok {
+			return modelId, nil
+		}
+		if dl, ok := ctx.Deadline(); ok && time.Now().After(dl.Add(-2*dbtProjectPollInterval)) {
+			return "", fmt.Errorf("timed out: model with name %v not found in project %v.", modelName, projectId)
+		}
+		helpers.ContextDelay(ctx, dbtProjectPollInterval)
+	}
+}
+
+func findDbtModelId(resp dbt.DbtModelsListResponse, modelName string) (string, bool) {
+	for _, item := range resp.Data.Items {
+		if item.ModelName == modelName {
+			return item.ID, true
+		}
+	}
+	return "", false
+}
@@ -9,6 +9,8 @@ import (
 	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core"
 	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core/model"
 	fivetranSchema "github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core/schema"
+	"github.com/fivetran/terraform-provider-fivetran/modules/fivetranapi"
+	"github.com/fivetran/terraform-provider-fivetran/modules/helpers"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -26,6 +28,7 @@ type destination struct {
 // Ensure the implementation satisfies the desired interfaces.
 var _ resource.ResourceWithConfigure = &destination{}
 var _ resource.ResourceWithImportState = &destination{}
+var _ resource.ResourceWithValidateConfig = &destination{}
 var _ resource.ResourceWithUpgradeState = &destination{}
 
 func (r *destination) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -34,12 +37,24 @@ func (r *destination) Metadata(ctx context.Context, req resource.MetadataRequest
 
 func (r *destination) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Attributes: fivetranSchema.DestinationAttributesSchema().GetResourceSchema(),
+		Attributes: fivetranSchema.DestinationResourceAttributes(),
 		Blocks:     fivetranSchema.DestinationResourceBlocks(ctx),
 		Version:    1,
 	}
 }
 
+func (r *destination) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data model.DestinationResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(data.ValidateConfig()...)
+}
+
 func (r *destination) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
 	return map[int64]resource.StateUpgrader{
 		// State upgrade implementation from 0 (prior state version) to 3 (Schema.Version)
@@ -87,13 +102,17 @@ func (r *destination) Create(ctx context.Context, req resource.CreateRequest, re
 	runSetupTestsPlan := core.GetBoolOrDefault(data.RunSetupTests, true)
 	trustCertificatesPlan := core.GetBoolOrDefault(data.TrustCertificates, false)
 	trustFingerprintsPlan := core.GetBoolOrDefault(data.TrustFingerprints, false)
+	failOnSetupTestFailurePlan := core.GetBoolOrDefault(data.FailOnSetupTestFailure, false)
+
+	// setup tests can't pass before the connection method is set, so they are performed on networking update
+	hasNetworking := data.HasNetworking()
 
 	svc := r.GetClient().NewDestinationCreate().
 		Service(data.Service.ValueString()).
 		GroupID(data.GroupId.ValueString()).
 		Region(data.Region.ValueString()).
 		TimeZoneOffset(data.TimeZoneOffset.ValueString()).
-		RunSetupTests(runSetupTestsPlan).
+		RunSetupTests(runSetupTestsPlan && !hasNetworking).
 		TrustCertificates(trustCertificatesPlan).
 		TrustFingerprints(trustFingerprintsPlan).
 		ConfigCustom(&configMap)
@@ -110,6 +129,30 @@ func (r *destination) Create(ctx context.Context, req resource.CreateRequest, re
 		return
 	}
 
+	if hasNetworking {
+		networkingRequest := data.GetNetworkingModifyRequest()
+		networkingRequest.RunSetupTests = &runSetupTestsPlan
+		networkingRequest.TrustCertificates = &trustCertificatesPlan
+		networkingRequest.TrustFingerprints = &trustFingerprintsPlan
+
+		networkingResponse, err := fivetranapi.ModifyDestinationNetworking(ctx, r.GetClient(), response.Data.ID, networkingRequest)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Create Destination Resource.",
+				fmt.Sprintf("Error while setting connection method. %v; code: %v; message: %v", err, networkingResponse.Code, networkingResponse.Message),
+			)
+			// destination is already created, save it to the state to be able to fix or re-create it
+			data.ReadFromResponseWithTests(response)
+			data.RunSetupTests = types.BoolValue(runSetupTestsPlan)
+			data.TrustCertificates = types.BoolValue(trustCertificatesPlan)
+			data.TrustFingerprints = types.BoolValue(trustFingerprintsPlan)
+			data.FailOnSetupTestFailure = types.BoolValue(failOnSetupTestFailurePlan)
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			return
+		}
+		response = networkingResponse
+	}
+
 	// For some reason tests may fail on first run, but succeed on second
 	if runSetupTestsPlan && strings.ToLower(response.Data.SetupStatus) != "connected" {
 		resp.Diagnostics.AddWarning(
@@ -153,12 +196,23 @@ func (r *destination) Create(ctx context.Context, req resource.CreateRequest, re
 
 		// re-read destination details after setup-tests finished
 		data.ReadFromResponse(detailsResponse)
+		data.ReadSetupTests(stResponse.Data.SetupTests)
 	} else {
 		data.ReadFromResponseWithTests(response)
 	}
 	data.RunSetupTests = types.BoolValue(runSetupTestsPlan)
 	data.TrustCertificates = types.BoolValue(trustCertificatesPlan)
 	data.TrustFingerprints = types.BoolValue(trustFingerprintsPlan)
+	data.FailOnSetupTestFailure = types.BoolValue(failOnSetupTestFailurePlan)
+
+	if failOnSetupTestFailurePlan {
+		if failedTests := data.GetFailedSetupTests(); len(failedTests) > 0 {
+			resp.Diagnostics.AddError(
+				"Destination Setup Tests Failed.",
+				strings.Join(failedTests, "\n"),
+			)
+		}
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -186,12 +240,14 @@ func (r *destination) Read(ctx context.Context, req resource.ReadRequest, resp *
 		id = data.GroupId.ValueString()
 	}
 
-	response, err := r.GetClient().
-		NewDestinationDetails().
-		DestinationID(id).
-		DoCustom(ctx)
+	response, networkingResponse, err := fivetranapi.DestinationDetails(ctx, r.GetClient(), id)
 
 	if err != nil {
+		// If the resource does not exist (404), inform Terraform.
+		if helpers.IsNotFound(response.Code, err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Read error.",
 			fmt.Sprintf("%v; code: %v; message: %v", err, response.Code, response.Message),
@@ -200,6 +256,11 @@ func (r *destination) Read(ctx context.Context, req resource.ReadRequest, resp *
 	}
 
 	data.ReadFromResponse(response)
+
+	if data.HasNetworking() {
+		data.ReadNetworking(networkingResponse)
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 
 }
@@ -254,6 +315,24 @@ func (r *destination) Update(ctx context.Context, req resource.UpdateRequest, re
 	patch := model.PrepareConfigAuthPatch(stateConfigMap, planConfigMap, plan.Service.ValueString(), common.GetDestinationFieldsMap())
 
 	updatePerformed := false
+	if plan.NetworkingChanged(state) {
+		networkingRequest := plan.GetNetworkingModifyRequest()
+		networkingRequest.RunSetupTests = &runSetupTestsPlan
+		networkingRequest.TrustCertificates = &trustCertificatesPlan
+		networkingRequest.TrustFingerprints = &trustFingerprintsPlan
+
+		response, err := fivetranapi.ModifyDestinationNetworking(ctx, r.GetClient(), state.Id.ValueString(), networkingRequest)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Update Destination Resource.",
+				fmt.Sprintf("Error while setting connection method. %v; code: %v; message: %v", err, response.Code, response.Message),
+			)
+			return
+		}
+		updatePerformed = true
+		plan.ReadFromResponseWithTests(response)
+	}
+
 	if len(patch) > 0 || timeZoneHasChange || regionHasChange {
 		svc := r.GetClient().NewDestinationModify().
 			RunSetupTests(runSetupTestsPlan).
@@ -278,7 +357,7 @@ func (r *destination) Update(ctx context.Context, req resource.UpdateRequest, re
 		}
 		updatePerformed = true
 		plan.ReadFromResponseWithTests(response)
-	} else {
+	} else if !updatePerformed {
 		// If values of testing fields changed we should run tests
 		if runSetupTestsPlan && runSetupTestsPlan != runSetupTestsState ||
 			trustCertificatesPlan && trustCertificatesPlan != trustCertificatesState ||
@@ -323,6 +402,21 @@ func (r *destination) Update(ctx context.Context, req resource.UpdateRequest, re
 	if plan.TrustFingerprints.IsUnknown() {
 		plan.TrustFingerprints = state.TrustFingerprints
 	}
+	if plan.FailOnSetupTestFailure.IsUnknown() {
+		plan.FailOnSetupTestFailure = state.FailOnSetupTestFailure
+	}
+	if plan.SetupTests.IsUnknown() {
+		plan.SetupTests = state.SetupTests
+	}
+
+	if updatePerformed && core.GetBoolOrDefault(plan.FailOnSetupTestFailure, false) {
+		if failedTests := plan.GetFailedSetupTests(); len(failedTests) > 0 {
+			resp.Diagnostics.AddError(
+				"Destination Setup Tests Failed.",
+				strings.Join(failedTests, "\n"),
+			)
+		}
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
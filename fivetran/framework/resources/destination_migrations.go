@@ -46,6 +46,13 @@ func upgradeDestinationState(ctx context.Context, req resource.UpgradeStateReque
 			"trust_fingerprints": convertStringStateValueToBool("trust_fingerprints", rawState["trust_fingerprints"], resp.Diagnostics),
 			"trust_certificates": convertStringStateValueToBool("trust_certificates", rawState["trust_certificates"], resp.Diagnostics),
 
+			"fail_on_setup_test_failure": tftypes.NewValue(tftypes.Bool, nil),
+			"networking_method":          tftypes.NewValue(tftypes.String, nil),
+			"private_link_id":            tftypes.NewValue(tftypes.String, nil),
+			"hybrid_deployment_agent_id": tftypes.NewValue(tftypes.String, nil),
+			"setup_tests":                tftypes.NewValue(setupTestsStateType, nil),
+			"config_json":                tftypes.NewValue(tftypes.String, nil),
+
 			"config": convertSetToBlock(
 				"config",
 				rawState["config"],
@@ -76,6 +83,12 @@ func getDestinationStateModel(version int) tftypes.Type {
 		base["run_setup_tests"] = tftypes.Bool
 		base["trust_certificates"] = tftypes.Bool
 		base["trust_fingerprints"] = tftypes.Bool
+		base["fail_on_setup_test_failure"] = tftypes.Bool
+		base["networking_method"] = tftypes.String
+		base["private_link_id"] = tftypes.String
+		base["hybrid_deployment_agent_id"] = tftypes.String
+		base["setup_tests"] = setupTestsStateType
+		base["config_json"] = tftypes.String
 
 		base["config"] = tftypes.Object{AttributeTypes: model.GetTfTypesDestination(common.GetDestinationFieldsMap(), 1)}
 	} else {
@@ -1,12 +1,17 @@
 package resources_test
 
 import (
+	"fmt"
 	"net/http"
+	"regexp"
+	"sort"
 	"strconv"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/fivetran/go-fivetran/tests/mock"
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/common"
 	tfmock "github.com/fivetran/terraform-provider-fivetran/fivetran/tests/mock"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -14,17 +19,105 @@ import (
 )
 
 func TestResourceDestinationMappingMock(t *testing.T) {
+	// config fields of the destination services with the values expected in the request payload
+	servicesConfig := map[string]map[string]interface{}{
+		"snowflake": {
+			"host":                     "host",
+			"port":                     float64(123),
+			"database":                 "database",
+			"auth":                     "auth",
+			"user":                     "user",
+			"password":                 "password",
+			"connection_type":          "connection_type",
+			"private_key":              "private_key",
+			"role":                     "role",
+			"is_private_key_encrypted": false,
+			"passphrase":               "passphrase",
+		},
+		"redshift": {
+			"host":            "host",
+			"port":            float64(123),
+			"database":        "database",
+			"user":            "user",
+			"password":        "password",
+			"connection_type": "connection_type",
+			"tunnel_host":     "tunnel_host",
+			"tunnel_port":     float64(123),
+			"tunnel_user":     "tunnel_user",
+			"auth_type":       "auth_type",
+			"role_arn":        "role_arn",
+			"cluster_id":      "cluster_id",
+			"cluster_region":  "cluster_region",
+		},
+		"big_query": {
+			"project_id":        "project_id",
+			"data_set_location": "data_set_location",
+			"bucket":            "bucket",
+			"secret_key":        "secret_key",
+		},
+		"databricks": {
+			"server_host_name":       "server_host_name",
+			"http_path":              "http_path",
+			"personal_access_token":  "personal_access_token",
+			"create_external_tables": false,
+			"external_location":      "external_location",
+			"catalog":                "catalog",
+		},
+		"new_s3_datalake": {
+			"bucket":            "bucket",
+			"fivetran_role_arn": "fivetran_role_arn",
+			"prefix_path":       "prefix_path",
+			"region":            "region",
+		},
+		"onelake": {
+			"prefix_path":          "prefix_path",
+			"storage_account_name": "storage_account_name",
+			"container_name":       "container_name",
+			"tenant_id":            "tenant_id",
+			"client_id":            "client_id",
+			"secret_value":         "secret_value",
+			"workspace_name":       "workspace_name",
+			"lakehouse_name":       "lakehouse_name",
+		},
+	}
+
+	for service, config := range servicesConfig {
+		t.Run(service, func(t *testing.T) {
+			testResourceDestinationMappingMock(t, service, config)
+		})
+	}
+}
+
+func testResourceDestinationMappingMock(t *testing.T, service string, expectedConfig map[string]interface{}) {
 	var testDestinationData map[string]interface{}
 	var destinationMappingGetHandler *mock.Handler
 	var destinationMappingPostHandler *mock.Handler
 	var destinationMappingDeleteHandler *mock.Handler
+
+	fieldNames := make([]string, 0, len(expectedConfig))
+	for fn := range expectedConfig {
+		fieldNames = append(fieldNames, fn)
+	}
+	sort.Strings(fieldNames)
+
+	tfConfig := make([]string, 0, len(fieldNames))
+	responseConfig := make(map[string]interface{})
+	for _, fn := range fieldNames {
+		tfConfig = append(tfConfig, fmt.Sprintf("%v = \"%v\"", fn, expectedConfig[fn]))
+		if common.GetDestinationFieldsMap()[fn].Sensitive {
+			responseConfig[fn] = "******"
+		} else {
+			responseConfig[fn] = fmt.Sprintf("%v", expectedConfig[fn])
+		}
+	}
+
 	step1 := resource.TestStep{
-		Config: `
+		Config: fmt.Sprintf(`
 			resource "fivetran_destination" "mydestination" {
 				provider = fivetran-provider
 
 				group_id = "group_id"
-				service = "snowflake"
+				service = "%v"
 				time_zone_offset = "0"
 				region = "GCP_US_EAST4"
 				trust_certificates = "true"
@@ -32,46 +125,9 @@ func TestResourceDestinationMappingMock(t *testing.T) {
 				run_setup_tests = "false"
 
 				config {
-					host = "host"
-					port = "123"
-					database = "database"
-					auth = "auth"
-					user = "user"
-					password = "password"
-					connection_type = "connection_type"
-					tunnel_host = "tunnel_host"
-					tunnel_port = "123"
-					tunnel_user = "tunnel_user"
-					project_id = "project_id"
-					data_set_location = "data_set_location"
-					bucket = "bucket"
-					server_host_name = "server_host_name"
-					http_path = "http_path"
-					personal_access_token = "personal_access_token"
-					create_external_tables = "false"
-					external_location = "external_location"
-					auth_type = "auth_type"
-					role_arn = "role_arn"
-					secret_key = "secret_key"
-					private_key = "private_key"
-					cluster_id = "cluster_id"
-					cluster_region = "cluster_region"
-					role = "role"
-					is_private_key_encrypted = "false"
-					passphrase = "passphrase"
-					catalog = "catalog"
-					fivetran_role_arn = "fivetran_role_arn"
-					prefix_path = "prefix_path"
-					region = "region"
-					storage_account_name = "storage_account_name"
-					container_name = "container_name"
-					tenant_id = "tenant_id"
-					client_id = "client_id"
-					secret_value = "secret_value"
-					workspace_name = "workspace_name"
-					lakehouse_name = "lakehouse_name"
+					%v
 				}
-			}`,
+			}`, service, strings.Join(tfConfig, "\n\t\t\t\t\t")),
 
 		Check: resource.ComposeAggregateTestCheckFunc(
 			func(s *terraform.State) error {
@@ -104,52 +160,15 @@ func TestResourceDestinationMappingMock(t *testing.T) {
 
 						config := body["config"].(map[string]interface{})
 
-						tfmock.AssertKeyExistsAndHasValue(t, config, "host", "host")
-
-						tfmock.AssertKeyExistsAndHasValue(t, config, "port", float64(123))
-						tfmock.AssertKeyExistsAndHasValue(t, config, "database", "database")
-						tfmock.AssertKeyExistsAndHasValue(t, config, "auth", "auth")
-						tfmock.AssertKeyExistsAndHasValue(t, config, "user", "user")
-						tfmock.AssertKeyExistsAndHasValue(t, config, "password", "password")
-						tfmock.AssertKeyExistsAndHasValue(t, config, "connection_type", "connection_type")
-						tfmock.AssertKeyExistsAndHasValue(t, config, "tunnel_host", "tunnel_host")
-						tfmock.AssertKeyExistsAndHasValue(t, config, "tunnel_user", "tunnel_user")
-						tfmock.AssertKeyExistsAndHasValue(t, config, "project_id", "project_id")
-						tfmock.AssertKeyExistsAndHasValue(t, config, "data_set_location", "data_set_location")
-						tfmock.AssertKeyExistsAndHasValue(t, config, "bucket", "bucket")
-						tfmock.AssertKeyExistsAndHasValue(t, config, "server_host_name", "server_host_name")
-						tfmock.AssertKeyExistsAndHasValue(t, config, "http_path", "http_path")
-						tfmock.AssertKeyExistsAndHasValue(t, config, "personal_access_token", "personal_access_token")
-						tfmock.AssertKeyExistsAndHasValue(t, config, "create_external_tables", false)
-						tfmock.AssertKeyExistsAndHasValue(t, config, "external_location", "external_location")
-						tfmock.AssertKeyExistsAndHasValue(t, config, "auth_type", "auth_type")
-						tfmock.AssertKeyExistsAndHasValue(t, config, "role_arn", "role_arn")
-						tfmock.AssertKeyExistsAndHasValue(t, config, "secret_key", "secret_key")
-						tfmock.AssertKeyExistsAndHasValue(t, config, "private_key", "private_key")
-						tfmock.AssertKeyExistsAndHasValue(t, config, "cluster_id", "cluster_id")
-						tfmock.AssertKeyExistsAndHasValue(t, config, "cluster_region", "cluster_region")
-						tfmock.AssertKeyExistsAndHasValue(t, config, "role", "role")
-						tfmock.AssertKeyExistsAndHasValue(t, config, "is_private_key_encrypted", false)
-						tfmock.AssertKeyExistsAndHasValue(t, config, "passphrase", "passphrase")
-						tfmock.AssertKeyExistsAndHasValue(t, config, "catalog", "catalog")
-						tfmock.AssertKeyExistsAndHasValue(t, config, "fivetran_role_arn", "fivetran_role_arn")
-						tfmock.AssertKeyExistsAndHasValue(t, config, "prefix_path", "prefix_path")
-						tfmock.AssertKeyExistsAndHasValue(t, config, "region", "region")
-						tfmock.AssertKeyExistsAndHasValue(t, config, "storage_account_name", "storage_account_name")
-						tfmock.AssertKeyExistsAndHasValue(t, config, "container_name", "container_name")
-						tfmock.AssertKeyExistsAndHasValue(t, config, "tenant_id", "tenant_id")
-						tfmock.AssertKeyExistsAndHasValue(t, config, "client_id", "client_id")
-						tfmock.AssertKeyExistsAndHasValue(t, config, "secret_value", "secret_value")
-						tfmock.AssertKeyExistsAndHasValue(t, config, "workspace_name", "workspace_name")
-						tfmock.AssertKeyExistsAndHasValue(t, config, "lakehouse_name", "lakehouse_name")
-
-						tfmock.AssertKeyExistsAndHasValue(t, config, "tunnel_port", float64(123))
-
-						testDestinationData = tfmock.CreateMapFromJsonString(t, `
+						for _, fn := range fieldNames {
+							tfmock.AssertKeyExistsAndHasValue(t, config, fn, expectedConfig[fn])
+						}
+
+						testDestinationData = tfmock.CreateMapFromJsonString(t, fmt.Sprintf(`
 						{
 							"id":"destination_id",
 							"group_id":"group_id",
-							"service":"snowflake",
+							"service":"%v",
 							"region":"GCP_US_EAST4",
 							"time_zone_offset":"0",
 							"setup_status":"connected",
@@ -170,49 +189,10 @@ func TestResourceDestinationMappingMock(t *testing.T) {
 									"message":""
 								}
 							],
-							"config":{
-								"host":                     "host",
-								"port":                     "123",
-								"database":                 "database",
-								"auth":                     "auth",
-								"user":                     "user",
-								"password":                 "******",
-								"connection_type":          "connection_type",
-								"tunnel_host":              "tunnel_host",
-								"tunnel_port":              "123",
-								"tunnel_user":              "tunnel_user",
-								"project_id":               "project_id",
-								"data_set_location":        "data_set_location",
-								"bucket":                   "bucket",
-								"server_host_name":         "server_host_name",
-								"http_path":                "http_path",
-								"personal_access_token":    "******",
-								"create_external_tables":   "false",
-								"external_location":        "external_location",
-								"auth_type":                "auth_type",
-								"role_arn":                 "******",
-								"secret_key":               "******",
-								"private_key":              "******",
-								"public_key":               "public_key",
-								"cluster_id":               "cluster_id",
-								"cluster_region":           "cluster_region",
-								"role":                     "role",
-								"is_private_key_encrypted": "false",
-								"passphrase":               "******",
-								"catalog": 					"catalog",
-								"fivetran_role_arn": 		"fivetran_role_arn",
-								"prefix_path": 				"prefix_path",
-								"region": 					"region",
-								"storage_account_name": 	"storage_account_name",
-								"container_name": 			"container_name",
-								"tenant_id": 				"tenant_id",
-								"client_id": 				"client_id",
-								"secret_value":				"******",
-								"workspace_name": 			"workspace_name",
-								"lakehouse_name": 			"lakehouse_name"
-							}
+							"config":{}
 						}
-						`)
+						`, service))
+						testDestinationData["config"] = responseConfig
 						return tfmock.FivetranSuccessResponse(t, req, http.StatusCreated, "Success", testDestinationData), nil
 					},
 				)
@@ -263,6 +243,13 @@ func TestResourceDestinationSetupTests(t *testing.T) {
 					connection_type = "Directly"
 				}
 			}`,
+		Check: resource.ComposeAggregateTestCheckFunc(
+			resource.TestCheckResourceAttr("fivetran_destination.mydestination", "setup_tests.#", "3"),
+			resource.TestCheckResourceAttr("fivetran_destination.mydestination", "setup_tests.0.title", "Host Connection"),
+			resource.TestCheckResourceAttr("fivetran_destination.mydestination", "setup_tests.0.status", "FAILED"),
+			resource.TestCheckResourceAttr("fivetran_destination.mydestination", "setup_tests.0.message", "Host Connection error"),
+			resource.TestCheckResourceAttr("fivetran_destination.mydestination", "fail_on_setup_test_failure", "false"),
+		),
 	}
 	testDestinationData := tfmock.CreateMapFromJsonString(t, `
 	{
@@ -358,6 +345,240 @@ func TestResourceDestinationSetupTests(t *testing.T) {
 	)
 }
 
+func TestResourceDestinationFailOnSetupTestFailureMock(t *testing.T) {
+	step1 := resource.TestStep{
+		Config: `
+			resource "fivetran_destination" "mydestination" {
+				provider = fivetran-provider
+
+				group_id = "group_id"
+				service = "snowflake"
+				time_zone_offset = "0"
+				region = "GCP_US_EAST4"
+				run_setup_tests = true
+				fail_on_setup_test_failure = true
+
+				config {
+					host = "terraform-test.us-east-1.rds.amazonaws.com"
+					port = 5432
+					user = "postgres"
+					password = "password"
+					database = "fivetran"
+					connection_type = "Directly"
+				}
+			}`,
+		ExpectError: regexp.MustCompile(`Host Connection: Host Connection error; status: FAILED`),
+	}
+	testDestinationData := tfmock.CreateMapFromJsonString(t, `
+	{
+		"id":"destination_id",
+		"group_id":"group_id",
+		"service":"snowflake",
+		"region":"GCP_US_EAST4",
+		"time_zone_offset":"0",
+		"setup_status":"incomplete",
+		"setup_tests":[
+			{
+				"title":"Host Connection",
+				"status":"FAILED",
+				"message":"Host Connection error"
+			},
+			{
+				"title":"Permission Test",
+				"status":"PASSED",
+				"message":""
+			}
+		],
+		"config":{
+			"host": "terraform-test.us-east-1.rds.amazonaws.com",
+			"port": "5432",
+			"user": "postgres",
+			"password": "password",
+			"database": "fivetran",
+			"connection_type": "Directly"
+		}
+	}
+	`)
+
+	var postHandler *mock.Handler
+	var deleteHandler *mock.Handler
+
+	resource.Test(
+		t,
+		resource.TestCase{
+			PreCheck: func() {
+				tfmock.MockClient().Reset()
+
+				postHandler = tfmock.MockClient().When(http.MethodPost, "/v1/destinations").ThenCall(
+					func(req *http.Request) (*http.Response, error) {
+						response := tfmock.FivetranSuccessResponse(t, req, http.StatusCreated,
+							"Destination has been created", testDestinationData)
+						return response, nil
+					},
+				)
+
+				tfmock.MockClient().When(http.MethodGet, "/v1/destinations/destination_id").ThenCall(
+					func(req *http.Request) (*http.Response, error) {
+						response := tfmock.FivetranSuccessResponse(t, req, http.StatusOK, "", testDestinationData)
+						return response, nil
+					},
+				)
+
+				tfmock.MockClient().When(http.MethodPost, "/v1/destinations/destination_id/test").ThenCall(
+					func(req *http.Request) (*http.Response, error) {
+						response := tfmock.FivetranSuccessResponse(t, req, http.StatusOK, "Setup tests have been completed", testDestinationData)
+						return response, nil
+					},
+				)
+
+				deleteHandler = tfmock.MockClient().When(http.MethodDelete, "/v1/destinations/destination_id").ThenCall(
+					func(req *http.Request) (*http.Response, error) {
+						response := tfmock.FivetranSuccessResponse(t, req, 200,
+							"Destination with id 'destionation_id' has been deleted", nil)
+						return response, nil
+					},
+				)
+			},
+			CheckDestroy: func(s *terraform.State) error {
+				tfmock.AssertEqual(t, postHandler.Interactions, 1)
+				// tainted destination is removed on destroy
+				tfmock.AssertEqual(t, deleteHandler.Interactions, 1)
+				return nil
+			},
+			ProtoV6ProviderFactories: tfmock.ProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				step1,
+			},
+		},
+	)
+}
+
+func TestResourceDestinationNetworkingMock(t *testing.T) {
+	var postHandler *mock.Handler
+	var patchHandler *mock.Handler
+	var deleteHandler *mock.Handler
+	var destinationData map[string]interface{}
+
+	destinationConfig := `
+			resource "fivetran_destination" "mydestination" {
+				provider = fivetran-provider
+
+				group_id = "group_id"
+				service = "snowflake"
+				time_zone_offset = "0"
+				region = "AWS_US_EAST_1"
+				run_setup_tests = true
+				networking_method = "PrivateLink"
+				private_link_id = "%v"
+
+				config {
+					host = "account.privatelink.snowflakecomputing.com"
+					port = 443
+					user = "fivetran"
+					password = "password"
+					database = "fivetran"
+				}
+			}`
+
+	step1 := resource.TestStep{
+		Config: fmt.Sprintf(destinationConfig, "private_link_id"),
+		Check: resource.ComposeAggregateTestCheckFunc(
+			func(s *terraform.State) error {
+				tfmock.AssertEqual(t, postHandler.Interactions, 1)
+				tfmock.AssertEqual(t, patchHandler.Interactions, 1)
+				return nil
+			},
+			resource.TestCheckResourceAttr("fivetran_destination.mydestination", "networking_method", "PrivateLink"),
+			resource.TestCheckResourceAttr("fivetran_destination.mydestination", "private_link_id", "private_link_id"),
+			resource.TestCheckResourceAttr("fivetran_destination.mydestination", "setup_status", "connected"),
+		),
+	}
+
+	step2 := resource.TestStep{
+		Config: fmt.Sprintf(destinationConfig, "another_private_link_id"),
+		Check: resource.ComposeAggregateTestCheckFunc(
+			func(s *terraform.State) error {
+				tfmock.AssertEqual(t, postHandler.Interactions, 1)
+				tfmock.AssertEqual(t, patchHandler.Interactions, 2)
+				return nil
+			},
+			resource.TestCheckResourceAttr("fivetran_destination.mydestination", "private_link_id", "another_private_link_id"),
+		),
+	}
+
+	resource.Test(
+		t,
+		resource.TestCase{
+			PreCheck: func() {
+				tfmock.MockClient().Reset()
+				destinationData = nil
+
+				postHandler = tfmock.MockClient().When(http.MethodPost, "/v1/destinations").ThenCall(
+					func(req *http.Request) (*http.Response, error) {
+						body := tfmock.RequestBodyToJson(t, req)
+						// setup tests are performed after the connection method is set
+						tfmock.AssertKeyExistsAndHasValue(t, body, "run_setup_tests", false)
+						destinationData = tfmock.CreateMapFromJsonString(t, `
+						{
+							"id":"destination_id",
+							"group_id":"group_id",
+							"service":"snowflake",
+							"region":"AWS_US_EAST_1",
+							"time_zone_offset":"0",
+							"setup_status":"incomplete",
+							"networking_method":"Directly",
+							"setup_tests":[],
+							"config":{
+								"host": "account.privatelink.snowflakecomputing.com",
+								"port": "443",
+								"user": "fivetran",
+								"password": "password",
+								"database": "fivetran"
+							}
+						}
+						`)
+						return tfmock.FivetranSuccessResponse(t, req, http.StatusCreated, "Destination has been created", destinationData), nil
+					},
+				)
+
+				patchHandler = tfmock.MockClient().When(http.MethodPatch, "/v1/destinations/destination_id").ThenCall(
+					func(req *http.Request) (*http.Response, error) {
+						body := tfmock.RequestBodyToJson(t, req)
+						tfmock.AssertKeyExistsAndHasValue(t, body, "networking_method", "PrivateLink")
+						tfmock.AssertKeyExistsAndHasValue(t, body, "run_setup_tests", true)
+						destinationData["networking_method"] = body["networking_method"]
+						destinationData["private_link_id"] = tfmock.AssertKeyExists(t, body, "private_link_id")
+						destinationData["setup_status"] = "connected"
+						return tfmock.FivetranSuccessResponse(t, req, http.StatusOK, "Destination has been updated", destinationData), nil
+					},
+				)
+
+				tfmock.MockClient().When(http.MethodGet, "/v1/destinations/destination_id").ThenCall(
+					func(req *http.Request) (*http.Response, error) {
+						return tfmock.FivetranSuccessResponse(t, req, http.StatusOK, "", destinationData), nil
+					},
+				)
+
+				deleteHandler = tfmock.MockClient().When(http.MethodDelete, "/v1/destinations/destination_id").ThenCall(
+					func(req *http.Request) (*http.Response, error) {
+						return tfmock.FivetranSuccessResponse(t, req, 200,
+							"Destination with id 'destionation_id' has been deleted", nil), nil
+					},
+				)
+			},
+			CheckDestroy: func(s *terraform.State) error {
+				tfmock.AssertEqual(t, deleteHandler.Interactions, 1)
+				return nil
+			},
+			ProtoV6ProviderFactories: tfmock.ProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				step1,
+				step2,
+			},
+		},
+	)
+}
+
 func TestResourceDestinationMock(t *testing.T) {
 	var destinationPostHandler *mock.Handler
 	var destinationPatchHandler *mock.Handler
@@ -598,3 +819,35 @@ func TestResourceDestinationMock(t *testing.T) {
 		},
 	)
 }
+
+func TestResourceDestinationConfigValidationMock(t *testing.T) {
+	resource.Test(
+		t,
+		resource.TestCase{
+			PreCheck: func() {
+				tfmock.MockClient().Reset()
+			},
+			ProtoV6ProviderFactories: tfmock.ProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: `
+					resource "fivetran_destination" "mydestination" {
+						provider = fivetran-provider
+
+						group_id = "group_id"
+						service = "snowflake"
+						time_zone_offset = "0"
+						region = "GCP_US_EAST4"
+
+						config {
+							host = "host"
+							bucket = "bucket"
+						}
+					}`,
+					PlanOnly:    true,
+					ExpectError: regexp.MustCompile("Field `bucket` isn't supported for service\\s+`snowflake`"),
+				},
+			},
+		},
+	)
+}
@@ -0,0 +1,233 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core"
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core/model"
+	fivetranSchema "github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core/schema"
+	"github.com/fivetran/terraform-provider-fivetran/modules/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+func ExternalLogging() resource.Resource {
+	return &externalLogging{}
+}
+
+type externalLogging struct {
+	core.ProviderResource
+}
+
+// Ensure the implementation satisfies the desired interfaces.
+var _ resource.ResourceWithConfigure = &externalLogging{}
+var _ resource.ResourceWithImportState = &externalLogging{}
+var _ resource.ResourceWithUpgradeState = &externalLogging{}
+
+func (r *externalLogging) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_external_logging"
+}
+
+func (r *externalLogging) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = fivetranSchema.ExternalLoggingResource()
+	resp.Schema.Version = 1
+}
+
+func (r *externalLogging) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		// State upgrade implementation from 0 (SDKv2 resource state) to 1 (Schema.Version)
+		0: {
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				stateModel := getExternalLoggingStateModel()
+				state, ok := readSdkState(req, resp, stateModel, nil)
+				if !ok {
+					return
+				}
+				state["config"] = nullIfZeroInBlock(state["config"])
+				writeUpgradedState(resp, stateModel, state)
+			},
+		},
+	}
+}
+
+func (r *externalLogging) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *externalLogging) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.GetClient() == nil {
+		resp.Diagnostics.AddError(
+			"Unconfigured Fivetran Client",
+			"Please report this issue to the provider developers.",
+		)
+
+		return
+	}
+
+	var data model.ExternalLoggingResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config := data.GetConfigMap(nil)
+
+	svc := r.GetClient().NewExternalLoggingCreate()
+	svc.GroupId(data.GroupId.ValueString())
+	svc.Service(data.Service.ValueString())
+	svc.Enabled(data.Enabled.ValueBool())
+	svc.ConfigCustom(&config)
+
+	createResponse, err := svc.DoCustom(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create External Logging Resource.",
+			fmt.Sprintf("%v; code: %v; message: %v", err, createResponse.Code, createResponse.Message),
+		)
+		return
+	}
+
+	externalLoggingResponse, err := r.GetClient().NewExternalLoggingDetails().ExternalLoggingId(createResponse.Data.Id).Do(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read External Logging Resource after creation.",
+			fmt.Sprintf("%v; code: %v; message: %v", err, externalLoggingResponse.Code, externalLoggingResponse.Message),
+		)
+		return
+	}
+
+	data.ReadFromResponse(externalLoggingResponse)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *externalLogging) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if r.GetClient() == nil {
+		resp.Diagnostics.AddError(
+			"Unconfigured Fivetran Client",
+			"Please report this issue to the provider developers.",
+		)
+
+		return
+	}
+
+	var data model.ExternalLoggingResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	externalLoggingResponse, err := r.GetClient().NewExternalLoggingDetails().ExternalLoggingId(data.Id.ValueString()).Do(ctx)
+
+	if err != nil {
+		// If the resource does not exist (404), inform Terraform.
+		if helpers.IsNotFound(externalLoggingResponse.Code, err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Unable to Read External Logging Resource.",
+			fmt.Sprintf("%v; code: %v; message: %v", err, externalLoggingResponse.Code, externalLoggingResponse.Message),
+		)
+		return
+	}
+
+	data.ReadFromResponse(externalLoggingResponse)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *externalLogging) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.GetClient() == nil {
+		resp.Diagnostics.AddError(
+			"Unconfigured Fivetran Client",
+			"Please report this issue to the provider developers.",
+		)
+
+		return
+	}
+
+	var plan, state model.ExternalLoggingResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	enabledChanged := !plan.Enabled.Equal(state.Enabled)
+	configChanged := !plan.Config.Equal(state.Config)
+	runSetupTests := plan.RunSetupTests.ValueBool()
+
+	if enabledChanged || configChanged {
+		svc := r.GetClient().NewExternalLoggingModify().ExternalLoggingId(state.Id.ValueString())
+		if enabledChanged {
+			svc.Enabled(plan.Enabled.ValueBool())
+		}
+		if configChanged {
+			config := plan.GetConfigMap(&state)
+			svc.ConfigCustom(&config)
+		}
+		if runSetupTests {
+			svc.RunSetupTests(runSetupTests)
+		}
+
+		updateResponse, err := svc.DoCustom(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Update External Logging Resource.",
+				fmt.Sprintf("%v; code: %v; message: %v", err, updateResponse.Code, updateResponse.Message),
+			)
+			return
+		}
+	} else if runSetupTests && !plan.RunSetupTests.Equal(state.RunSetupTests) {
+		// if only run_setup_tests is updated to true the setup tests are performed without the update request
+		testsResponse, err := r.GetClient().NewExternalLoggingSetupTests().ExternalLoggingId(state.Id.ValueString()).Do(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Update External Logging Resource.",
+				fmt.Sprintf("%v; code: %v; message: %v", err, testsResponse.Code, testsResponse.Message),
+			)
+			return
+		}
+	}
+
+	externalLoggingResponse, err := r.GetClient().NewExternalLoggingDetails().ExternalLoggingId(state.Id.ValueString()).Do(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read External Logging Resource after update.",
+			fmt.Sprintf("%v; code: %v; message: %v", err, externalLoggingResponse.Code, externalLoggingResponse.Message),
+		)
+		return
+	}
+
+	plan.ReadFromResponse(externalLoggingResponse)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *externalLogging) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.GetClient() == nil {
+		resp.Diagnostics.AddError(
+			"Unconfigured Fivetran Client",
+			"Please report this issue to the provider developers.",
+		)
+
+		return
+	}
+
+	var data model.ExternalLoggingResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	deleteResponse, err := r.GetClient().NewExternalLoggingDelete().ExternalLoggingId(data.Id.ValueString()).Do(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Delete External Logging Resource.",
+			fmt.Sprintf("%v; code: %v; message: %v", err, deleteResponse.Code, deleteResponse.Message),
+		)
+		return
+	}
+}
@@ -0,0 +1,181 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core"
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core/model"
+	fivetranSchema "github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core/schema"
+	"github.com/fivetran/terraform-provider-fivetran/modules/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+func Group() resource.Resource {
+	return &group{}
+}
+
+type group struct {
+	core.ProviderResource
+}
+
+// Ensure the implementation satisfies the desired interfaces.
+var _ resource.ResourceWithConfigure = &group{}
+var _ resource.ResourceWithImportState = &group{}
+var _ resource.ResourceWithUpgradeState = &group{}
+
+func (r *group) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_group"
+}
+
+func (r *group) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: fivetranSchema.Group().GetResourceSchema(),
+		Version:    1,
+	}
+}
+
+func (r *group) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		// State upgrade implementation from 0 (SDKv2 resource state) to 1 (Schema.Version)
+		0: {
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				upgradeSdkState(ctx, req, resp, getGroupStateModel(), sdkLastUpdated)
+			},
+		},
+	}
+}
+
+func (r *group) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *group) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.GetClient() == nil {
+		resp.Diagnostics.AddError(
+			"Unconfigured Fivetran Client",
+			"Please report this issue to the provider developers.",
+		)
+
+		return
+	}
+
+	var data model.Group
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createResponse, err := r.GetClient().NewGroupCreate().Name(data.Name.ValueString()).Do(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Group Resource.",
+			fmt.Sprintf("%v; code: %v; message: %v", err, createResponse.Code, createResponse.Message),
+		)
+
+		return
+	}
+
+	data.ReadFromResponse(createResponse)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *group) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if r.GetClient() == nil {
+		resp.Diagnostics.AddError(
+			"Unconfigured Fivetran Client",
+			"Please report this issue to the provider developers.",
+		)
+
+		return
+	}
+
+	var data model.Group
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	groupResponse, err := r.GetClient().NewGroupDetails().GroupID(data.Id.ValueString()).Do(ctx)
+
+	if err != nil {
+		// If the resource does not exist (404), inform Terraform.
+		if helpers.IsNotFound(groupResponse.Code, err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Unable to Read Group Resource.",
+			fmt.Sprintf("%v; code: %v; message: %v", err, groupResponse.Code, groupResponse.Message),
+		)
+		return
+	}
+
+	data.ReadFromResponse(groupResponse)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *group) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.GetClient() == nil {
+		resp.Diagnostics.AddError(
+			"Unconfigured Fivetran Client",
+			"Please report this issue to the provider developers.",
+		)
+
+		return
+	}
+
+	var plan, state model.Group
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateResponse, err := r.GetClient().NewGroupModify().
+		GroupID(state.Id.ValueString()).
+		Name(plan.Name.ValueString()).
+		Do(ctx)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Update Group Resource.",
+			fmt.Sprintf("%v; code: %v; message: %v", err, updateResponse.Code, updateResponse.Message),
+		)
+		return
+	}
+
+	state.ReadFromResponse(updateResponse)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *group) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.GetClient() == nil {
+		resp.Diagnostics.AddError(
+			"Unconfigured Fivetran Client",
+			"Please report this issue to the provider developers.",
+		)
+
+		return
+	}
+
+	var data model.Group
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	deleteResponse, err := r.GetClient().NewGroupDelete().GroupID(data.Id.ValueString()).Do(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Delete Group Resource.",
+			fmt.Sprintf("%v; code: %v; message: %v", err, deleteResponse.Code, deleteResponse.Message),
+		)
+		return
+	}
+}
@@ -0,0 +1,229 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core"
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core/model"
+	fivetranSchema "github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core/schema"
+	"github.com/fivetran/terraform-provider-fivetran/modules/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+func GroupUser() resource.Resource {
+	return &groupUser{}
+}
+
+type groupUser struct {
+	core.ProviderResource
+}
+
+// Ensure the implementation satisfies the desired interfaces.
+var _ resource.ResourceWithConfigure = &groupUser{}
+var _ resource.ResourceWithImportState = &groupUser{}
+
+func (r *groupUser) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_group_user"
+}
+
+func (r *groupUser) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: fivetranSchema.GroupUser().GetResourceSchema(),
+	}
+}
+
+// ImportState accepts `{group_id}:{email}`
+func (r *groupUser) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	groupId, email, found := strings.Cut(req.ID, ":")
+	if !found || groupId == "" || email == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier.",
+			fmt.Sprintf("Expected import identifier with format: `{group_id}:{email}`. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("group_id"), groupId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("email"), email)...)
+}
+
+func (r *groupUser) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.GetClient() == nil {
+		resp.Diagnostics.AddError(
+			"Unconfigured Fivetran Client",
+			"Please report this issue to the provider developers.",
+		)
+
+		return
+	}
+
+	var data model.GroupUser
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.setUserRole(ctx, &data, &resp.Diagnostics, "Unable to Create Group User Resource.")
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *groupUser) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if r.GetClient() == nil {
+		resp.Diagnostics.AddError(
+			"Unconfigured Fivetran Client",
+			"Please report this issue to the provider developers.",
+		)
+
+		return
+	}
+
+	var data model.GroupUser
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	groupId := data.GroupId.ValueString()
+
+	listResponse, err := listGroupUsers(ctx, r.GetClient(), groupId)
+	if err != nil {
+		// If the group does not exist (404), inform Terraform.
+		if helpers.IsNotFound(listResponse.Code, err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Unable to Read Group User Resource.",
+			fmt.Sprintf("%v; code: %v; message: %v", err, listResponse.Code, listResponse.Message),
+		)
+		return
+	}
+
+	remoteUser, exists := mapGroupUsersByEmail(listResponse)[data.Email.ValueString()]
+	if !exists {
+		// the user was removed from the group outside of Terraform
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.ReadFromResponse(groupId, remoteUser)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *groupUser) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.GetClient() == nil {
+		resp.Diagnostics.AddError(
+			"Unconfigured Fivetran Client",
+			"Please report this issue to the provider developers.",
+		)
+
+		return
+	}
+
+	var plan model.GroupUser
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// only the role can be updated, other fields require replacement
+	r.setUserRole(ctx, &plan, &resp.Diagnostics, "Unable to Update Group User Resource.")
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *groupUser) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.GetClient() == nil {
+		resp.Diagnostics.AddError(
+			"Unconfigured Fivetran Client",
+			"Please report this issue to the provider developers.",
+		)
+
+		return
+	}
+
+	var data model.GroupUser
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	groupId := data.GroupId.ValueString()
+
+	listResponse, err := listGroupUsers(ctx, r.GetClient(), groupId)
+	if err != nil {
+		if helpers.IsNotFound(listResponse.Code, err) {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Unable to Delete Group User Resource.",
+			fmt.Sprintf("%v; code: %v; message: %v", err, listResponse.Code, listResponse.Message),
+		)
+		return
+	}
+
+	if remoteUser, exists := mapGroupUsersByEmail(listResponse)[data.Email.ValueString()]; exists {
+		if err := removeUserFromGroup(ctx, r.GetClient(), groupId, remoteUser.ID); err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Delete Group User Resource.",
+				err.Error(),
+			)
+		}
+	}
+}
+
+// setUserRole adds the user to the group with the planned role without touching other users of the group
+func (r *groupUser) setUserRole(ctx context.Context, data *model.GroupUser, diagnostics *diag.Diagnostics, errorSummary string) {
+	groupId := data.GroupId.ValueString()
+	email := data.Email.ValueString()
+
+	listResponse, err := listGroupUsers(ctx, r.GetClient(), groupId)
+	if err != nil {
+		diagnostics.AddError(
+			errorSummary,
+			fmt.Sprintf("%v; code: %v; message: %v", err, listResponse.Code, listResponse.Message),
+		)
+		return
+	}
+
+	if err := updateUserRoleInGroup(ctx, r.GetClient(), groupId, email, data.Role.ValueString(), mapGroupUsersByEmail(listResponse)); err != nil {
+		diagnostics.AddError(
+			errorSummary,
+			err.Error(),
+		)
+		return
+	}
+
+	listResponse, err = listGroupUsers(ctx, r.GetClient(), groupId)
+	if err != nil {
+		diagnostics.AddError(
+			"Unable to Read Group User Resource.",
+			fmt.Sprintf("%v; code: %v; message: %v", err, listResponse.Code, listResponse.Message),
+		)
+		return
+	}
+
+	remoteUser, exists := mapGroupUsersByEmail(listResponse)[email]
+	if !exists {
+		diagnostics.AddError(
+			"Unable to Read Group User Resource.",
+			fmt.Sprintf("User %v is not found in the group %v after adding.", email, groupId),
+		)
+		return
+	}
+
+	data.ReadFromResponse(groupId, remoteUser)
+}
@@ -0,0 +1,347 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/fivetran/go-fivetran"
+	"github.com/fivetran/go-fivetran/groups"
+	"github.com/fivetran/go-fivetran/users"
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core"
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core/model"
+	fivetranSchema "github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core/schema"
+	"github.com/fivetran/terraform-provider-fivetran/modules/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+func GroupUsers() resource.Resource {
+	return &groupUsers{}
+}
+
+type groupUsers struct {
+	core.ProviderResource
+}
+
+// Ensure the implementation satisfies the desired interfaces.
+var _ resource.ResourceWithConfigure = &groupUsers{}
+var _ resource.ResourceWithImportState = &groupUsers{}
+var _ resource.ResourceWithUpgradeState = &groupUsers{}
+
+func (r *groupUsers) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_group_users"
+}
+
+func (r *groupUsers) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: fivetranSchema.GroupUsers().GetResourceSchema(),
+		Blocks:     fivetranSchema.GroupUsersBlocks(),
+		Version:    1,
+	}
+}
+
+func (r *groupUsers) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		// State upgrade implementation from 0 (SDKv2 resource state) to 1 (Schema.Version)
+		0: {
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				upgradeSdkState(ctx, req, resp, getGroupUsersStateModel(), sdkLastUpdated)
+			},
+		},
+	}
+}
+
+// ImportState accepts group id: all the users of the group are imported
+func (r *groupUsers) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("group_id"), req.ID)...)
+}
+
+func (r *groupUsers) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.GetClient() == nil {
+		resp.Diagnostics.AddError(
+			"Unconfigured Fivetran Client",
+			"Please report this issue to the provider developers.",
+		)
+
+		return
+	}
+
+	var data model.GroupUsers
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	groupResponse, err := r.GetClient().NewGroupDetails().GroupID(data.GroupId.ValueString()).Do(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Group Users Resource.",
+			fmt.Sprintf("%v; code: %v; message: %v", err, groupResponse.Code, groupResponse.Message),
+		)
+
+		return
+	}
+
+	groupId := groupResponse.Data.ID
+	localRoles := data.GetRoles()
+
+	if addedRoles, err := r.syncUsers(ctx, groupId, localRoles, nil, data.IsAuthoritative()); err != nil {
+		// cleanup the users added to the group by this create, users that were already in the group are kept
+		if deleteErr := r.deleteUsers(ctx, groupId, addedRoles); deleteErr != nil {
+			resp.Diagnostics.AddError(
+				"Unable to cleanup Group Users after failure.",
+				deleteErr.Error(),
+			)
+		}
+		resp.Diagnostics.AddError(
+			"Unable to Create Group Users Resource.",
+			err.Error(),
+		)
+
+		return
+	}
+
+	listResponse, err := listGroupUsers(ctx, r.GetClient(), groupId)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Group Users Resource.",
+			fmt.Sprintf("%v; code: %v; message: %v", err, listResponse.Code, listResponse.Message),
+		)
+		return
+	}
+
+	data.ReadFromResponse(groupId, listResponse)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *groupUsers) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if r.GetClient() == nil {
+		resp.Diagnostics.AddError(
+			"Unconfigured Fivetran Client",
+			"Please report this issue to the provider developers.",
+		)
+
+		return
+	}
+
+	var data model.GroupUsers
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	groupId := data.Id.ValueString()
+
+	listResponse, err := listGroupUsers(ctx, r.GetClient(), groupId)
+	if err != nil {
+		// If the resource does not exist (404), inform Terraform.
+		if helpers.IsNotFound(listResponse.Code, err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Unable to Read Group Users Resource.",
+			fmt.Sprintf("%v; code: %v; message: %v", err, listResponse.Code, listResponse.Message),
+		)
+		return
+	}
+
+	data.ReadFromResponse(groupId, listResponse)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *groupUsers) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.GetClient() == nil {
+		resp.Diagnostics.AddError(
+			"Unconfigured Fivetran Client",
+			"Please report this issue to the provider developers.",
+		)
+
+		return
+	}
+
+	var plan, state model.GroupUsers
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	groupId := state.Id.ValueString()
+
+	if _, err := r.syncUsers(ctx, groupId, plan.GetRoles(), state.GetRoles(), plan.IsAuthoritative()); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Update Group Users Resource.",
+			err.Error(),
+		)
+
+		return
+	}
+
+	listResponse, err := listGroupUsers(ctx, r.GetClient(), groupId)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Group Users Resource.",
+			fmt.Sprintf("%v; code: %v; message: %v", err, listResponse.Code, listResponse.Message),
+		)
+		return
+	}
+
+	plan.ReadFromResponse(groupId, listResponse)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *groupUsers) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.GetClient() == nil {
+		resp.Diagnostics.AddError(
+			"Unconfigured Fivetran Client",
+			"Please report this issue to the provider developers.",
+		)
+
+		return
+	}
+
+	var data model.GroupUsers
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if err := r.deleteUsers(ctx, data.GroupId.ValueString(), data.GetRoles()); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Delete Group Users Resource.",
+			err.Error(),
+		)
+	}
+}
+
+// syncUsers adds and updates roles of the group users to match the given roles mapped by user email.
+// In authoritative mode all the other users are removed from the group, otherwise only the users
+// previously managed by the resource (previousRoles) that are not listed anymore are removed.
+// The users that were not in the group before are returned as addedRoles, also in case of errors.
+func (r *groupUsers) syncUsers(ctx context.Context, groupId string, localRoles, previousRoles map[string]string, authoritative bool) (addedRoles map[string]string, err error) {
+	addedRoles = make(map[string]string)
+
+	listResponse, err := listGroupUsers(ctx, r.GetClient(), groupId)
+	if err != nil {
+		return addedRoles, fmt.Errorf("%v; code: %v; message: %v", err, listResponse.Code, listResponse.Message)
+	}
+
+	remoteUsers := mapGroupUsersByEmail(listResponse)
+
+	for email, remoteUser := range remoteUsers {
+		if _, found := localRoles[email]; found {
+			continue
+		}
+		if _, managed := previousRoles[email]; authoritative || managed {
+			if err := removeUserFromGroup(ctx, r.GetClient(), groupId, remoteUser.ID); err != nil {
+				return addedRoles, err
+			}
+		}
+	}
+
+	for email, role := range localRoles {
+		if err := updateUserRoleInGroup(ctx, r.GetClient(), groupId, email, role, remoteUsers); err != nil {
+			return addedRoles, err
+		}
+		if _, exists := remoteUsers[email]; !exists {
+			addedRoles[email] = role
+		}
+	}
+
+	return addedRoles, nil
+}
+
+// deleteUsers removes users with the given emails from the group, trying to remove all of them in case of errors
+func (r *groupUsers) deleteUsers(ctx context.Context, groupId string, localRoles map[string]string) error {
+	listResponse, err := listGroupUsers(ctx, r.GetClient(), groupId)
+	if err != nil {
+		return fmt.Errorf("%v; code: %v; message: %v", err, listResponse.Code, listResponse.Message)
+	}
+
+	remoteUsers := mapGroupUsersByEmail(listResponse)
+
+	var messages []string
+	for email := range localRoles {
+		if remoteUser, exists := remoteUsers[email]; exists {
+			if err := removeUserFromGroup(ctx, r.GetClient(), groupId, remoteUser.ID); err != nil {
+				messages = append(messages, err.Error())
+			}
+		}
+	}
+	if len(messages) > 0 {
+		return errors.New(strings.Join(messages, "\n"))
+	}
+
+	return nil
+}
+
+// updateUserRoleInGroup adds the user to the group with the given role if the user is not in the group yet or has another role
+func updateUserRoleInGroup(ctx context.Context, client *fivetran.Client, groupId, email, role string, remoteUsers map[string]users.UserDetailsData) error {
+	remoteUser, exists := remoteUsers[email]
+	if exists && remoteUser.Role == role {
+		return nil
+	}
+	if exists {
+		// role can't be modified, so the user is removed from the group and added again with the new role
+		if err := removeUserFromGroup(ctx, client, groupId, remoteUser.ID); err != nil {
+			return err
+		}
+	}
+	if resp, err := client.NewGroupAddUser().GroupID(groupId).Email(email).Role(role).Do(ctx); err != nil {
+		return fmt.Errorf("%v; code: %v; message: %v", err, resp.Code, resp.Message)
+	}
+	return nil
+}
+
+func removeUserFromGroup(ctx context.Context, client *fivetran.Client, groupId, userId string) error {
+	if resp, err := client.NewGroupRemoveUser().GroupID(groupId).UserID(userId).Do(ctx); err != nil {
+		return fmt.Errorf("%v; code: %v; message: %v", err, resp.Code, resp.Message)
+	}
+	return nil
+}
+
+func listGroupUsers(ctx context.Context, client *fivetran.Client, groupId string) (groups.GroupListUsersResponse, error) {
+	var resp groups.GroupListUsersResponse
+	var respNextCursor string
+
+	for {
+		var err error
+		var respInner groups.GroupListUsersResponse
+		svc := client.NewGroupListUsers().GroupID(groupId).Limit(1000)
+		if respNextCursor == "" {
+			respInner, err = svc.Do(ctx)
+		} else {
+			respInner, err = svc.Cursor(respNextCursor).Do(ctx)
+		}
+		if err != nil {
+			return respInner, err
+		}
+
+		resp.Data.Items = append(resp.Data.Items, respInner.Data.Items...)
+
+		if respInner.Data.NextCursor == "" {
+			break
+		}
+
+		respNextCursor = respInner.Data.NextCursor
+	}
+
+	return resp, nil
+}
+
+// mapGroupUsersByEmail maps group users by email omitting the group creator
+func mapGroupUsersByEmail(resp groups.GroupListUsersResponse) map[string]users.UserDetailsData {
+	result := make(map[string]users.UserDetailsData)
+	for _, v := range resp.Data.Items {
+		if v.Role == "" {
+			continue
+		}
+		result[v.Email] = v
+	}
+	return result
+}
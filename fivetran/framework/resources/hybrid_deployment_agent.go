@@ -0,0 +1,171 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core"
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core/model"
+	fivetranSchema "github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core/schema"
+	"github.com/fivetran/terraform-provider-fivetran/modules/fivetranapi"
+	"github.com/fivetran/terraform-provider-fivetran/modules/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+func HybridDeploymentAgent() resource.Resource {
+	return &hybridDeploymentAgent{}
+}
+
+type hybridDeploymentAgent struct {
+	core.ProviderResource
+}
+
+// Ensure the implementation satisfies the desired interfaces.
+var _ resource.ResourceWithConfigure = &hybridDeploymentAgent{}
+var _ resource.ResourceWithImportState = &hybridDeploymentAgent{}
+
+func (r *hybridDeploymentAgent) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_hybrid_deployment_agent"
+}
+
+func (r *hybridDeploymentAgent) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = fivetranSchema.GetHybridDeploymentAgentResourceSchema()
+}
+
+func (r *hybridDeploymentAgent) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *hybridDeploymentAgent) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.GetClient() == nil {
+		resp.Diagnostics.AddError(
+			"Unconfigured Fivetran Client",
+			"Please report this issue to the provider developers.",
+		)
+
+		return
+	}
+
+	var data model.HybridDeploymentAgent
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createResponse, err := fivetranapi.CreateHybridDeploymentAgent(ctx, r.GetClient(),
+		data.GroupId.ValueString(),
+		data.DisplayName.ValueString(),
+		data.EnvType.ValueString(),
+		data.AuthType.ValueString(),
+		data.AcceptTerms.ValueBool())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Hybrid Deployment Agent Resource.",
+			fmt.Sprintf("%v; code: %v; message: %v", err, createResponse.Code, createResponse.Message),
+		)
+		return
+	}
+
+	data.ReadFromCreateResponse(createResponse)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *hybridDeploymentAgent) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if r.GetClient() == nil {
+		resp.Diagnostics.AddError(
+			"Unconfigured Fivetran Client",
+			"Please report this issue to the provider developers.",
+		)
+
+		return
+	}
+
+	var data model.HybridDeploymentAgent
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	response, err := fivetranapi.HybridDeploymentAgentDetailsById(ctx, r.GetClient(), data.Id.ValueString())
+
+	if err != nil {
+		// If the resource does not exist (404), inform Terraform.
+		if helpers.IsNotFound(response.Code, err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Read error.",
+			fmt.Sprintf("%v; code: %v; message: %v", err, response.Code, response.Message),
+		)
+		return
+	}
+
+	data.ReadFromResponse(response)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *hybridDeploymentAgent) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.GetClient() == nil {
+		resp.Diagnostics.AddError(
+			"Unconfigured Fivetran Client",
+			"Please report this issue to the provider developers.",
+		)
+
+		return
+	}
+
+	var plan, state model.HybridDeploymentAgent
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Id = state.Id
+	plan.RegisteredAt = state.RegisteredAt
+	plan.Token = state.Token
+	plan.ConfigFile = state.ConfigFile
+	plan.AuthFile = state.AuthFile
+
+	// Changing `authentication_counter` is the only way to trigger re-authentication, other fields require replacement
+	if !plan.AuthenticationCounter.Equal(state.AuthenticationCounter) {
+		reAuthResponse, err := fivetranapi.ReAuthHybridDeploymentAgent(ctx, r.GetClient(), state.Id.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Re-authenticate Hybrid Deployment Agent.",
+				fmt.Sprintf("%v; code: %v; message: %v", err, reAuthResponse.Code, reAuthResponse.Message),
+			)
+			return
+		}
+		plan.ReadFromCreateResponse(reAuthResponse)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *hybridDeploymentAgent) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.GetClient() == nil {
+		resp.Diagnostics.AddError(
+			"Unconfigured Fivetran Client",
+			"Please report this issue to the provider developers.",
+		)
+
+		return
+	}
+
+	var data model.HybridDeploymentAgent
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	deleteResponse, err := fivetranapi.DeleteHybridDeploymentAgent(ctx, r.GetClient(), data.Id.ValueString())
+	if err != nil && !helpers.IsNotFound(deleteResponse.Code, err) {
+		resp.Diagnostics.AddError(
+			"Unable to Delete Hybrid Deployment Agent Resource.",
+			fmt.Sprintf("%v; code: %v; message: %v", err, deleteResponse.Code, deleteResponse.Message),
+		)
+	}
+}
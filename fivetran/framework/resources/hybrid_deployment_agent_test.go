@@ -0,0 +1,166 @@
+package resources_test
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/fivetran/go-fivetran/tests/mock"
+	tfmock "github.com/fivetran/terraform-provider-fivetran/fivetran/tests/mock"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestResourceHybridDeploymentAgentMock(t *testing.T) {
+	var postHandler *mock.Handler
+	var reAuthHandler *mock.Handler
+	var deleteHandler *mock.Handler
+
+	agentConfig := `
+		resource "fivetran_hybrid_deployment_agent" "test_agent" {
+			provider = fivetran-provider
+
+			group_id = "group_id"
+			display_name = "agent"
+			env_type = "DOCKER"
+			auth_type = "AUTO"
+			accept_terms = true
+			authentication_counter = %v
+		}`
+
+	step1 := resource.TestStep{
+		Config: fmt.Sprintf(agentConfig, 1),
+
+		Check: resource.ComposeAggregateTestCheckFunc(
+			func(s *terraform.State) error {
+				tfmock.AssertEqual(t, postHandler.Interactions, 1)
+				tfmock.AssertEqual(t, reAuthHandler.Interactions, 0)
+				return nil
+			},
+			resource.TestCheckResourceAttr("fivetran_hybrid_deployment_agent.test_agent", "id", "agent_id"),
+			resource.TestCheckResourceAttr("fivetran_hybrid_deployment_agent.test_agent", "group_id", "group_id"),
+			resource.TestCheckResourceAttr("fivetran_hybrid_deployment_agent.test_agent", "registered_at", "2024-01-01T00:00:00Z"),
+			resource.TestCheckResourceAttr("fivetran_hybrid_deployment_agent.test_agent", "token", "token_1"),
+			resource.TestCheckResourceAttr("fivetran_hybrid_deployment_agent.test_agent", "config_file", "config_1"),
+			resource.TestCheckResourceAttr("fivetran_hybrid_deployment_agent.test_agent", "auth_file", "auth_1"),
+		),
+	}
+
+	step2 := resource.TestStep{
+		Config: fmt.Sprintf(agentConfig, 2),
+
+		Check: resource.ComposeAggregateTestCheckFunc(
+			func(s *terraform.State) error {
+				tfmock.AssertEqual(t, postHandler.Interactions, 1)
+				tfmock.AssertEqual(t, reAuthHandler.Interactions, 1)
+				return nil
+			},
+			resource.TestCheckResourceAttr("fivetran_hybrid_deployment_agent.test_agent", "id", "agent_id"),
+			resource.TestCheckResourceAttr("fivetran_hybrid_deployment_agent.test_agent", "token", "token_2"),
+			resource.TestCheckResourceAttr("fivetran_hybrid_deployment_agent.test_agent", "config_file", "config_2"),
+			resource.TestCheckResourceAttr("fivetran_hybrid_deployment_agent.test_agent", "auth_file", "auth_2"),
+		),
+	}
+
+	agentResponse := func(credentialsVersion int) map[string]interface{} {
+		return tfmock.CreateMapFromJsonString(t, fmt.Sprintf(`
+		{
+			"id": "agent_id",
+			"display_name": "agent",
+			"group_id": "group_id",
+			"registered_at": "2024-01-01T00:00:00Z",
+			"files": {
+				"config_json": "config_%[1]v",
+				"auth_json": "auth_%[1]v",
+				"docker_compose_yaml": "docker_compose_%[1]v"
+			},
+			"token": "token_%[1]v"
+		}
+		`, credentialsVersion))
+	}
+
+	resource.Test(
+		t,
+		resource.TestCase{
+			PreCheck: func() {
+				tfmock.MockClient().Reset()
+
+				postHandler = tfmock.MockClient().When(http.MethodPost, "/v1/hybrid-deployment-agents").ThenCall(
+					func(req *http.Request) (*http.Response, error) {
+						body := tfmock.RequestBodyToJson(t, req)
+						tfmock.AssertKeyExistsAndHasValue(t, body, "group_id", "group_id")
+						tfmock.AssertKeyExistsAndHasValue(t, body, "display_name", "agent")
+						tfmock.AssertKeyExistsAndHasValue(t, body, "env_type", "DOCKER")
+						tfmock.AssertKeyExistsAndHasValue(t, body, "auth_type", "AUTO")
+						tfmock.AssertKeyExistsAndHasValue(t, body, "accept_terms", true)
+						return tfmock.FivetranSuccessResponse(t, req, http.StatusCreated, "Agent has been created", agentResponse(1)), nil
+					},
+				)
+
+				tfmock.MockClient().When(http.MethodGet, "/v1/hybrid-deployment-agents/agent_id").ThenCall(
+					func(req *http.Request) (*http.Response, error) {
+						return tfmock.FivetranSuccessResponse(t, req, http.StatusOK, "Success",
+							tfmock.CreateMapFromJsonString(t, `
+							{
+								"id": "agent_id",
+								"display_name": "agent",
+								"group_id": "group_id",
+								"registered_at": "2024-01-01T00:00:00Z"
+							}
+							`)), nil
+					},
+				)
+
+				reAuthHandler = tfmock.MockClient().When(http.MethodPatch, "/v1/hybrid-deployment-agents/agent_id/re-auth").ThenCall(
+					func(req *http.Request) (*http.Response, error) {
+						return tfmock.FivetranSuccessResponse(t, req, http.StatusOK, "Agent has been re-authenticated", agentResponse(2)), nil
+					},
+				)
+
+				deleteHandler = tfmock.MockClient().When(http.MethodDelete, "/v1/hybrid-deployment-agents/agent_id").ThenCall(
+					func(req *http.Request) (*http.Response, error) {
+						return tfmock.FivetranSuccessResponse(t, req, http.StatusOK, "Agent has been deleted", nil), nil
+					},
+				)
+			},
+			ProtoV6ProviderFactories: tfmock.ProtoV6ProviderFactories,
+			CheckDestroy: func(s *terraform.State) error {
+				tfmock.AssertEqual(t, deleteHandler.Interactions, 1)
+				return nil
+			},
+			Steps: []resource.TestStep{
+				step1,
+				step2,
+			},
+		},
+	)
+}
+
+func TestResourceHybridDeploymentAgentTermsNotAcceptedMock(t *testing.T) {
+	resource.Test(
+		t,
+		resource.TestCase{
+			PreCheck: func() {
+				tfmock.MockClient().Reset()
+			},
+			ProtoV6ProviderFactories: tfmock.ProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: `
+					resource "fivetran_hybrid_deployment_agent" "test_agent" {
+						provider = fivetran-provider
+
+						group_id = "group_id"
+						display_name = "agent"
+						env_type = "DOCKER"
+						auth_type = "AUTO"
+						accept_terms = false
+					}`,
+					PlanOnly:    true,
+					ExpectError: regexp.MustCompile(`Hybrid Deployment terms are not accepted`),
+				},
+			},
+		},
+	)
+}
@@ -0,0 +1,204 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core"
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core/model"
+	"github.com/fivetran/terraform-provider-fivetran/modules/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+// membership manages a single membership of a team, unlike memberships it doesn't own all the memberships of the team
+type membership struct {
+	core.ProviderResource
+
+	typeName    string
+	title       string
+	memberField string
+	attributes  func() map[string]schema.Attribute
+	api         core.MembershipsApi
+	newModel    func() model.Membership
+}
+
+// Ensure the implementation satisfies the desired interfaces.
+var _ resource.ResourceWithConfigure = &membership{}
+var _ resource.ResourceWithImportState = &membership{}
+
+func (r *membership) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + r.typeName
+}
+
+func (r *membership) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: r.attributes(),
+	}
+}
+
+// ImportState accepts `{team_id}:{member_id}`
+func (r *membership) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	teamId, memberId, ok := model.ParseTeamMemberId(req.ID)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier.",
+			fmt.Sprintf("Expected import identifier with format: `{team_id}:{%v}`. Got: %q", r.memberField, req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("team_id"), teamId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(r.memberField), memberId)...)
+}
+
+func (r *membership) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.GetClient() == nil {
+		resp.Diagnostics.AddError(
+			"Unconfigured Fivetran Client",
+			"Please report this issue to the provider developers.",
+		)
+
+		return
+	}
+
+	data := r.newModel()
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createResponse, err := r.api.Create(ctx, r.GetClient(), data.GetOwnerId(), data.GetMemberId(), data.GetRole())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Unable to Create %v Resource.", r.title),
+			fmt.Sprintf("%v; code: %v; message: %v", err, createResponse.Code, createResponse.Message),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(r.read(ctx, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
+
+func (r *membership) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if r.GetClient() == nil {
+		resp.Diagnostics.AddError(
+			"Unconfigured Fivetran Client",
+			"Please report this issue to the provider developers.",
+		)
+
+		return
+	}
+
+	data := r.newModel()
+
+	resp.Diagnostics.Append(req.State.Get(ctx, data)...)
+
+	ownerId := data.GetOwnerId()
+
+	item, code, err := r.api.Details(ctx, r.GetClient(), ownerId, data.GetMemberId())
+	if err != nil {
+		// If the membership does not exist (404), inform Terraform.
+		if helpers.IsNotFound(code, err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Unable to Read %v Resource.", r.title),
+			fmt.Sprintf("%v; code: %v", err, code),
+		)
+		return
+	}
+
+	data.ReadFromResponse(ownerId, item)
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
+
+func (r *membership) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.GetClient() == nil {
+		resp.Diagnostics.AddError(
+			"Unconfigured Fivetran Client",
+			"Please report this issue to the provider developers.",
+		)
+
+		return
+	}
+
+	plan := r.newModel()
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// only the role can be updated, other fields require replacement
+	modifyResponse, err := r.api.Modify(ctx, r.GetClient(), plan.GetOwnerId(), plan.GetMemberId(), plan.GetRole())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Unable to Update %v Resource.", r.title),
+			fmt.Sprintf("%v; code: %v; message: %v", err, modifyResponse.Code, modifyResponse.Message),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(r.read(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *membership) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.GetClient() == nil {
+		resp.Diagnostics.AddError(
+			"Unconfigured Fivetran Client",
+			"Please report this issue to the provider developers.",
+		)
+
+		return
+	}
+
+	data := r.newModel()
+
+	resp.Diagnostics.Append(req.State.Get(ctx, data)...)
+
+	deleteResponse, err := r.api.Delete(ctx, r.GetClient(), data.GetOwnerId(), data.GetMemberId())
+	if err != nil && !helpers.IsNotFound(deleteResponse.Code, err) {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Unable to Delete %v Resource.", r.title),
+			fmt.Sprintf("%v; code: %v; message: %v", err, deleteResponse.Code, deleteResponse.Message),
+		)
+	}
+}
+
+func (r *membership) read(ctx context.Context, data model.Membership) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	ownerId := data.GetOwnerId()
+
+	item, code, err := r.api.Details(ctx, r.GetClient(), ownerId, data.GetMemberId())
+	if err != nil {
+		diags.AddError(
+			fmt.Sprintf("Unable to Read %v Resource.", r.title),
+			fmt.Sprintf("%v; code: %v", err, code),
+		)
+		return diags
+	}
+
+	data.ReadFromResponse(ownerId, item)
+	return diags
+}
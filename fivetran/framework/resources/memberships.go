@@ -0,0 +1,252 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core"
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core/model"
+	"github.com/fivetran/terraform-provider-fivetran/modules/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// memberships manages all the memberships of a single member type (connector, group or user) of a team or a user
+type memberships struct {
+	core.ProviderResource
+
+	typeName   string
+	title      string
+	ownerField string
+	attributes func() map[string]schema.Attribute
+	blocks     func() map[string]schema.Block
+	api        core.MembershipsApi
+	newModel   func() model.Memberships
+	// sdkStateModel is defined for the resources migrated from SDKv2
+	sdkStateModel func() tftypes.Object
+}
+
+// Ensure the implementation satisfies the desired interfaces.
+var _ resource.ResourceWithConfigure = &memberships{}
+var _ resource.ResourceWithImportState = &memberships{}
+var _ resource.ResourceWithUpgradeState = &memberships{}
+
+func (r *memberships) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + r.typeName
+}
+
+func (r *memberships) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: r.attributes(),
+		Blocks:     r.blocks(),
+	}
+	if r.sdkStateModel != nil {
+		resp.Schema.Version = 1
+	}
+}
+
+func (r *memberships) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	if r.sdkStateModel == nil {
+		return map[int64]resource.StateUpgrader{}
+	}
+	return map[int64]resource.StateUpgrader{
+		// State upgrade implementation from 0 (SDKv2 resource state) to 1 (Schema.Version)
+		0: {
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				upgradeSdkState(ctx, req, resp, r.sdkStateModel(), nil)
+			},
+		},
+	}
+}
+
+// ImportState accepts owner id: all the memberships of the owner are imported
+func (r *memberships) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(r.ownerField), req.ID)...)
+}
+
+func (r *memberships) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.GetClient() == nil {
+		resp.Diagnostics.AddError(
+			"Unconfigured Fivetran Client",
+			"Please report this issue to the provider developers.",
+		)
+
+		return
+	}
+
+	data := r.newModel()
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ownerId := data.GetOwnerId()
+
+	if err := r.syncMemberships(ctx, ownerId, data.GetRoles()); err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Unable to Create %v Resource.", r.title),
+			err.Error(),
+		)
+
+		return
+	}
+
+	items, code, err := r.api.List(ctx, r.GetClient(), ownerId)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Unable to Read %v Resource.", r.title),
+			fmt.Sprintf("%v; code: %v", err, code),
+		)
+		return
+	}
+
+	data.ReadFromResponse(ownerId, items)
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
+
+func (r *memberships) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if r.GetClient() == nil {
+		resp.Diagnostics.AddError(
+			"Unconfigured Fivetran Client",
+			"Please report this issue to the provider developers.",
+		)
+
+		return
+	}
+
+	data := r.newModel()
+
+	resp.Diagnostics.Append(req.State.Get(ctx, data)...)
+
+	ownerId := data.GetOwnerId()
+
+	items, code, err := r.api.List(ctx, r.GetClient(), ownerId)
+	if err != nil {
+		// If the owner does not exist (404), inform Terraform.
+		if helpers.IsNotFound(code, err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Unable to Read %v Resource.", r.title),
+			fmt.Sprintf("%v; code: %v", err, code),
+		)
+		return
+	}
+
+	data.ReadFromResponse(ownerId, items)
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
+
+func (r *memberships) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.GetClient() == nil {
+		resp.Diagnostics.AddError(
+			"Unconfigured Fivetran Client",
+			"Please report this issue to the provider developers.",
+		)
+
+		return
+	}
+
+	plan, state := r.newModel(), r.newModel()
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ownerId := state.GetOwnerId()
+
+	if err := r.syncMemberships(ctx, ownerId, plan.GetRoles()); err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Unable to Update %v Resource.", r.title),
+			err.Error(),
+		)
+
+		return
+	}
+
+	items, code, err := r.api.List(ctx, r.GetClient(), ownerId)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Unable to Read %v Resource.", r.title),
+			fmt.Sprintf("%v; code: %v", err, code),
+		)
+		return
+	}
+
+	plan.ReadFromResponse(ownerId, items)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *memberships) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.GetClient() == nil {
+		resp.Diagnostics.AddError(
+			"Unconfigured Fivetran Client",
+			"Please report this issue to the provider developers.",
+		)
+
+		return
+	}
+
+	data := r.newModel()
+
+	resp.Diagnostics.Append(req.State.Get(ctx, data)...)
+
+	ownerId := data.GetOwnerId()
+
+	for memberId := range data.GetRoles() {
+		deleteResponse, err := r.api.Delete(ctx, r.GetClient(), ownerId, memberId)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Unable to Delete %v Resource.", r.title),
+				fmt.Sprintf("%v; code: %v; message: %v", err, deleteResponse.Code, deleteResponse.Message),
+			)
+			return
+		}
+	}
+}
+
+// syncMemberships creates, modifies and deletes memberships of the owner to match the given roles
+func (r *memberships) syncMemberships(ctx context.Context, ownerId string, localRoles map[string]string) error {
+	items, code, err := r.api.List(ctx, r.GetClient(), ownerId)
+	if err != nil {
+		return fmt.Errorf("%v; code: %v", err, code)
+	}
+
+	remoteRoles := make(map[string]string)
+	for _, v := range items {
+		remoteRoles[v.MemberId] = v.Role
+	}
+
+	for memberId, remoteRole := range remoteRoles {
+		role, found := localRoles[memberId]
+
+		if !found {
+			if resp, err := r.api.Delete(ctx, r.GetClient(), ownerId, memberId); err != nil {
+				return fmt.Errorf("%v; code: %v; message: %v", err, resp.Code, resp.Message)
+			}
+		} else if role != remoteRole {
+			if resp, err := r.api.Modify(ctx, r.GetClient(), ownerId, memberId, role); err != nil {
+				return fmt.Errorf("%v; code: %v; message: %v", err, resp.Code, resp.Message)
+			}
+		}
+	}
+
+	for memberId, role := range localRoles {
+		if _, exists := remoteRoles[memberId]; !exists {
+			if resp, err := r.api.Create(ctx, r.GetClient(), ownerId, memberId, role); err != nil {
+				return fmt.Errorf("%v; code: %v; message: %v", err, resp.Code, resp.Message)
+			}
+		}
+	}
+
+	return nil
+}
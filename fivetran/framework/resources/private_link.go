@@ -0,0 +1,240 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	privatelinks "github.com/fivetran/go-fivetran/private_links"
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core"
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core/model"
+	fivetranSchema "github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core/schema"
+	"github.com/fivetran/terraform-provider-fivetran/modules/fivetranapi"
+	"github.com/fivetran/terraform-provider-fivetran/modules/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+const (
+	privateLinkDefaultTimeout = 60 * time.Minute
+	privateLinkPollInterval   = 30 * time.Second
+)
+
+func PrivateLink() resource.Resource {
+	return &privateLink{}
+}
+
+type privateLink struct {
+	core.ProviderResource
+}
+
+// Ensure the implementation satisfies the desired interfaces.
+var _ resource.ResourceWithConfigure = &privateLink{}
+var _ resource.ResourceWithImportState = &privateLink{}
+
+func (r *privateLink) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_private_link"
+}
+
+func (r *privateLink) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = fivetranSchema.GetPrivateLinkResourceSchema(ctx)
+}
+
+func (r *privateLink) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *privateLink) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.GetClient() == nil {
+		resp.Diagnostics.AddError(
+			"Unconfigured Fivetran Client",
+			"Please report this issue to the provider developers.",
+		)
+
+		return
+	}
+
+	var data model.PrivateLink
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, privateLinkDefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createResponse, err := fivetranapi.CreatePrivateLink(ctx, r.GetClient(),
+		data.Name.ValueString(),
+		data.Region.ValueString(),
+		data.Service.ValueString(),
+		data.GetConfig())
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Private Link Resource.",
+			fmt.Sprintf("%v; code: %v; message: %v", err, createResponse.Code, createResponse.Message),
+		)
+		return
+	}
+
+	data.ReadFromResponse(createResponse)
+
+	waitCtx, cancel := helpers.SetContextTimeout(ctx, createTimeout)
+	defer cancel()
+
+	detailsResponse, err := r.waitForAvailable(waitCtx, data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Private Link Resource.",
+			fmt.Sprintf("%v; code: %v; message: %v", err, detailsResponse.Code, detailsResponse.Message),
+		)
+		// private link is already created, save it to the state to be able to fix or re-create it
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	data.ReadFromResponse(detailsResponse)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *privateLink) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if r.GetClient() == nil {
+		resp.Diagnostics.AddError(
+			"Unconfigured Fivetran Client",
+			"Please report this issue to the provider developers.",
+		)
+
+		return
+	}
+
+	var data model.PrivateLink
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	response, err := r.GetClient().NewPrivateLinksDetails().PrivateLinkId(data.Id.ValueString()).Do(ctx)
+
+	if err != nil {
+		// If the resource does not exist (404), inform Terraform.
+		if helpers.IsNotFound(response.Code, err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Read error.",
+			fmt.Sprintf("%v; code: %v; message: %v", err, response.Code, response.Message),
+		)
+		return
+	}
+
+	data.ReadFromResponse(response)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *privateLink) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.GetClient() == nil {
+		resp.Diagnostics.AddError(
+			"Unconfigured Fivetran Client",
+			"Please report this issue to the provider developers.",
+		)
+
+		return
+	}
+
+	var plan, state model.PrivateLink
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, privateLinkDefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.Id.ValueString()
+	plan.Id = state.Id
+
+	// name, region and service require replacement, so only config could be changed
+	if !plan.Config.Equal(state.Config) {
+		updateResponse, err := r.GetClient().NewPrivateLinksModify().PrivateLinkId(id).Config(plan.GetConfig()).Do(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Update Private Link Resource.",
+				fmt.Sprintf("%v; code: %v; message: %v", err, updateResponse.Code, updateResponse.Message),
+			)
+			return
+		}
+	}
+
+	waitCtx, cancel := helpers.SetContextTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	detailsResponse, err := r.waitForAvailable(waitCtx, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Update Private Link Resource.",
+			fmt.Sprintf("%v; code: %v; message: %v", err, detailsResponse.Code, detailsResponse.Message),
+		)
+		return
+	}
+
+	plan.ReadFromResponse(detailsResponse)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *privateLink) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.GetClient() == nil {
+		resp.Diagnostics.AddError(
+			"Unconfigured Fivetran Client",
+			"Please report this issue to the provider developers.",
+		)
+
+		return
+	}
+
+	var data model.PrivateLink
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	deleteResponse, err := r.GetClient().NewPrivateLinksDelete().PrivateLinkId(data.Id.ValueString()).Do(ctx)
+	if err != nil && !helpers.IsNotFound(deleteResponse.Code, err) {
+		resp.Diagnostics.AddError(
+			"Unable to Delete Private Link Resource.",
+			fmt.Sprintf("%v; code: %v; message: %v", err, deleteResponse.Code, deleteResponse.Message),
+		)
+	}
+}
+
+// waitForAvailable polls private link details until its state becomes `available`
+func (r *privateLink) waitForAvailable(ctx context.Context, id string) (privatelinks.PrivateLinksResponse, error) {
+	for {
+		response, err := r.GetClient().NewPrivateLinksDetails().PrivateLinkId(id).Do(ctx)
+		if err != nil {
+			return response, err
+		}
+
+		switch strings.ToLower(response.Data.State) {
+		case "available":
+			return response, nil
+		case "failed":
+			return response, fmt.Errorf("private link %v failed: %v", id, response.Data.StateSummary)
+		}
+
+		if err := helpers.ContextDelay(ctx, privateLinkPollInterval); err != nil {
+			return response, fmt.Errorf("private link %v is not available (state: %v; %v): %v",
+				id, response.Data.State, response.Data.StateSummary, err)
+		}
+	}
+}
@@ -0,0 +1,141 @@
+package resources_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/fivetran/go-fivetran/tests/mock"
+	tfmock "github.com/fivetran/terraform-provider-fivetran/fivetran/tests/mock"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestResourcePrivateLinkMock(t *testing.T) {
+	var postHandler *mock.Handler
+	var patchHandler *mock.Handler
+	var deleteHandler *mock.Handler
+	var privateLinkData map[string]interface{}
+
+	step1 := resource.TestStep{
+		Config: `
+		resource "fivetran_private_link" "test_pl" {
+			provider = fivetran-provider
+
+			name = "private_link"
+			region = "AWS_US_EAST_1"
+			service = "SOURCE_AWS"
+
+			config = {
+				connection_service_name = "com.amazonaws.vpce.us-east-1.vpce-svc-123"
+			}
+		}`,
+
+		Check: resource.ComposeAggregateTestCheckFunc(
+			func(s *terraform.State) error {
+				tfmock.AssertEqual(t, postHandler.Interactions, 1)
+				return nil
+			},
+			resource.TestCheckResourceAttr("fivetran_private_link.test_pl", "id", "private_link_id"),
+			resource.TestCheckResourceAttr("fivetran_private_link.test_pl", "cloud_provider", "AWS"),
+			resource.TestCheckResourceAttr("fivetran_private_link.test_pl", "state", "AVAILABLE"),
+			resource.TestCheckResourceAttr("fivetran_private_link.test_pl", "config.%", "1"),
+			resource.TestCheckResourceAttr("fivetran_private_link.test_pl", "config.connection_service_name", "com.amazonaws.vpce.us-east-1.vpce-svc-123"),
+		),
+	}
+
+	step2 := resource.TestStep{
+		Config: `
+		resource "fivetran_private_link" "test_pl" {
+			provider = fivetran-provider
+
+			name = "private_link"
+			region = "AWS_US_EAST_1"
+			service = "SOURCE_AWS"
+
+			config = {
+				connection_service_name = "com.amazonaws.vpce.us-east-1.vpce-svc-456"
+			}
+		}`,
+
+		Check: resource.ComposeAggregateTestCheckFunc(
+			func(s *terraform.State) error {
+				tfmock.AssertEqual(t, postHandler.Interactions, 1)
+				tfmock.AssertEqual(t, patchHandler.Interactions, 1)
+				return nil
+			},
+			resource.TestCheckResourceAttr("fivetran_private_link.test_pl", "id", "private_link_id"),
+			resource.TestCheckResourceAttr("fivetran_private_link.test_pl", "config.connection_service_name", "com.amazonaws.vpce.us-east-1.vpce-svc-456"),
+		),
+	}
+
+	resource.Test(
+		t,
+		resource.TestCase{
+			PreCheck: func() {
+				tfmock.MockClient().Reset()
+
+				postHandler = tfmock.MockClient().When(http.MethodPost, "/v1/private-links").ThenCall(
+					func(req *http.Request) (*http.Response, error) {
+						body := tfmock.RequestBodyToJson(t, req)
+						tfmock.AssertKeyExistsAndHasValue(t, body, "name", "private_link")
+						tfmock.AssertKeyExistsAndHasValue(t, body, "region", "AWS_US_EAST_1")
+						tfmock.AssertKeyExistsAndHasValue(t, body, "service", "SOURCE_AWS")
+						config := tfmock.AssertKeyExists(t, body, "config").(map[string]interface{})
+						privateLinkData = tfmock.CreateMapFromJsonString(t, `
+						{
+							"id": "private_link_id",
+							"name": "private_link",
+							"region": "AWS_US_EAST_1",
+							"service": "SOURCE_AWS",
+							"cloud_provider": "AWS",
+							"state": "PENDING",
+							"state_summary": "Private link is being provisioned",
+							"created_at": "2024-01-01T00:00:00Z",
+							"created_by": "user_id",
+							"config": {
+								"connection_service_name": "",
+								"aws_account_id": ""
+							}
+						}
+						`)
+						privateLinkData["config"].(map[string]interface{})["connection_service_name"] = config["connection_service_name"]
+						return tfmock.FivetranSuccessResponse(t, req, http.StatusCreated, "Private link has been created", privateLinkData), nil
+					},
+				)
+
+				tfmock.MockClient().When(http.MethodGet, "/v1/private-links/private_link_id").ThenCall(
+					func(req *http.Request) (*http.Response, error) {
+						// emulate instant provisioning
+						privateLinkData["state"] = "AVAILABLE"
+						privateLinkData["state_summary"] = "Private link is available"
+						return tfmock.FivetranSuccessResponse(t, req, http.StatusOK, "Success", privateLinkData), nil
+					},
+				)
+
+				patchHandler = tfmock.MockClient().When(http.MethodPatch, "/v1/private-links/private_link_id").ThenCall(
+					func(req *http.Request) (*http.Response, error) {
+						body := tfmock.RequestBodyToJson(t, req)
+						config := tfmock.AssertKeyExists(t, body, "config").(map[string]interface{})
+						privateLinkData["config"].(map[string]interface{})["connection_service_name"] = config["connection_service_name"]
+						return tfmock.FivetranSuccessResponse(t, req, http.StatusOK, "Private link has been updated", privateLinkData), nil
+					},
+				)
+
+				deleteHandler = tfmock.MockClient().When(http.MethodDelete, "/v1/private-links/private_link_id").ThenCall(
+					func(req *http.Request) (*http.Response, error) {
+						return tfmock.FivetranSuccessResponse(t, req, http.StatusOK, "Private link has been deleted", nil), nil
+					},
+				)
+			},
+			ProtoV6ProviderFactories: tfmock.ProtoV6ProviderFactories,
+			CheckDestroy: func(s *terraform.State) error {
+				tfmock.AssertEqual(t, deleteHandler.Interactions, 1)
+				return nil
+			},
+			Steps: []resource.TestStep{
+				step1,
+				step2,
+			},
+		},
+	)
+}
@@ -0,0 +1,159 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core"
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core/model"
+	fivetranSchema "github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core/schema"
+	"github.com/fivetran/terraform-provider-fivetran/modules/fivetranapi"
+	"github.com/fivetran/terraform-provider-fivetran/modules/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func ProxyAgent() resource.Resource {
+	return &proxyAgent{}
+}
+
+type proxyAgent struct {
+	core.ProviderResource
+}
+
+// Ensure the implementation satisfies the desired interfaces.
+var _ resource.ResourceWithConfigure = &proxyAgent{}
+var _ resource.ResourceWithImportState = &proxyAgent{}
+
+func (r *proxyAgent) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_proxy_agent"
+}
+
+func (r *proxyAgent) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = fivetranSchema.GetProxyAgentResourceSchema()
+}
+
+func (r *proxyAgent) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *proxyAgent) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.GetClient() == nil {
+		resp.Diagnostics.AddError(
+			"Unconfigured Fivetran Client",
+			"Please report this issue to the provider developers.",
+		)
+
+		return
+	}
+
+	var data model.ProxyAgent
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createResponse, err := fivetranapi.CreateProxyAgent(ctx, r.GetClient(), data.DisplayName.ValueString(), data.GroupRegion.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Proxy Agent Resource.",
+			fmt.Sprintf("%v; code: %v; message: %v", err, createResponse.Code, createResponse.Message),
+		)
+		return
+	}
+
+	data.ReadFromCreateResponse(createResponse)
+
+	detailsResponse, err := fivetranapi.ProxyAgentDetailsById(ctx, r.GetClient(), data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read after Create Proxy Agent Resource.",
+			fmt.Sprintf("%v; code: %v; message: %v", err, detailsResponse.Code, detailsResponse.Message),
+		)
+		// the agent is already created, save it to the state anyway: the token can't be retrieved again
+		data.AccountId = types.StringNull()
+		data.CreatedBy = types.StringNull()
+		data.RegisteredAt = types.StringNull()
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	data.ReadFromResponse(detailsResponse)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *proxyAgent) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if r.GetClient() == nil {
+		resp.Diagnostics.AddError(
+			"Unconfigured Fivetran Client",
+			"Please report this issue to the provider developers.",
+		)
+
+		return
+	}
+
+	var data model.ProxyAgent
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	response, err := fivetranapi.ProxyAgentDetailsById(ctx, r.GetClient(), data.Id.ValueString())
+
+	if err != nil {
+		// If the resource does not exist (404), inform Terraform.
+		if helpers.IsNotFound(response.Code, err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Read error.",
+			fmt.Sprintf("%v; code: %v; message: %v", err, response.Code, response.Message),
+		)
+		return
+	}
+
+	data.ReadFromResponse(response)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *proxyAgent) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// All the configurable fields require replacement, so just save the new plan
+	var plan, state model.ProxyAgent
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	plan.Id = state.Id
+	plan.Token = state.Token
+	plan.ProxyServerUri = state.ProxyServerUri
+	plan.AccountId = state.AccountId
+	plan.CreatedBy = state.CreatedBy
+	plan.RegisteredAt = state.RegisteredAt
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *proxyAgent) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.GetClient() == nil {
+		resp.Diagnostics.AddError(
+			"Unconfigured Fivetran Client",
+			"Please report this issue to the provider developers.",
+		)
+
+		return
+	}
+
+	var data model.ProxyAgent
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	deleteResponse, err := fivetranapi.DeleteProxyAgent(ctx, r.GetClient(), data.Id.ValueString())
+	if err != nil && !helpers.IsNotFound(deleteResponse.Code, err) {
+		resp.Diagnostics.AddError(
+			"Unable to Delete Proxy Agent Resource.",
+			fmt.Sprintf("%v; code: %v; message: %v", err, deleteResponse.Code, deleteResponse.Message),
+		)
+	}
+}
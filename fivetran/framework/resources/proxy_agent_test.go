@@ -0,0 +1,100 @@
+package resources_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/fivetran/go-fivetran/tests/mock"
+	tfmock "github.com/fivetran/terraform-provider-fivetran/fivetran/tests/mock"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestResourceProxyAgentMock(t *testing.T) {
+	var postHandler *mock.Handler
+	var getHandler *mock.Handler
+	var deleteHandler *mock.Handler
+
+	step1 := resource.TestStep{
+		Config: `
+		resource "fivetran_proxy_agent" "test_proxy_agent" {
+			provider = fivetran-provider
+
+			display_name = "proxy_agent"
+			group_region = "GCP_US_EAST4"
+		}`,
+
+		Check: resource.ComposeAggregateTestCheckFunc(
+			func(s *terraform.State) error {
+				tfmock.AssertEqual(t, postHandler.Interactions, 1)
+				tfmock.AssertNotEmpty(t, getHandler.Interactions)
+				return nil
+			},
+			resource.TestCheckResourceAttr("fivetran_proxy_agent.test_proxy_agent", "id", "proxy_agent_id"),
+			resource.TestCheckResourceAttr("fivetran_proxy_agent.test_proxy_agent", "display_name", "proxy_agent"),
+			resource.TestCheckResourceAttr("fivetran_proxy_agent.test_proxy_agent", "group_region", "GCP_US_EAST4"),
+			resource.TestCheckResourceAttr("fivetran_proxy_agent.test_proxy_agent", "token", "auth_token"),
+			resource.TestCheckResourceAttr("fivetran_proxy_agent.test_proxy_agent", "proxy_server_uri", "proxy.fivetran.com"),
+			resource.TestCheckResourceAttr("fivetran_proxy_agent.test_proxy_agent", "account_id", "account_id"),
+			resource.TestCheckResourceAttr("fivetran_proxy_agent.test_proxy_agent", "created_by", "user_id"),
+			resource.TestCheckResourceAttr("fivetran_proxy_agent.test_proxy_agent", "registered_at", "2024-01-01T00:00:00Z"),
+		),
+	}
+
+	resource.Test(
+		t,
+		resource.TestCase{
+			PreCheck: func() {
+				tfmock.MockClient().Reset()
+
+				postHandler = tfmock.MockClient().When(http.MethodPost, "/v1/proxy").ThenCall(
+					func(req *http.Request) (*http.Response, error) {
+						body := tfmock.RequestBodyToJson(t, req)
+						tfmock.AssertKeyExistsAndHasValue(t, body, "display_name", "proxy_agent")
+						tfmock.AssertKeyExistsAndHasValue(t, body, "group_region", "GCP_US_EAST4")
+						return tfmock.FivetranSuccessResponse(t, req, http.StatusCreated, "Proxy agent has been created",
+							tfmock.CreateMapFromJsonString(t, `
+							{
+								"agent_id": "proxy_agent_id",
+								"auth_token": "auth_token",
+								"proxy_server_uri": "proxy.fivetran.com"
+							}
+							`)), nil
+					},
+				)
+
+				getHandler = tfmock.MockClient().When(http.MethodGet, "/v1/proxy/proxy_agent_id").ThenCall(
+					func(req *http.Request) (*http.Response, error) {
+						return tfmock.FivetranSuccessResponse(t, req, http.StatusOK, "Success",
+							tfmock.CreateMapFromJsonString(t, `
+							{
+								"id": "proxy_agent_id",
+								"account_id": "account_id",
+								"registred_at": "2024-01-01T00:00:00Z",
+								"region": "GCP_US_EAST4",
+								"token": "",
+								"salt": "",
+								"created_by": "user_id",
+								"display_name": "proxy_agent"
+							}
+							`)), nil
+					},
+				)
+
+				deleteHandler = tfmock.MockClient().When(http.MethodDelete, "/v1/proxy/proxy_agent_id").ThenCall(
+					func(req *http.Request) (*http.Response, error) {
+						return tfmock.FivetranSuccessResponse(t, req, http.StatusOK, "Proxy agent has been deleted", nil), nil
+					},
+				)
+			},
+			ProtoV6ProviderFactories: tfmock.ProtoV6ProviderFactories,
+			CheckDestroy: func(s *terraform.State) error {
+				tfmock.AssertEqual(t, deleteHandler.Interactions, 1)
+				return nil
+			},
+			Steps: []resource.TestStep{
+				step1,
+			},
+		},
+	)
+}
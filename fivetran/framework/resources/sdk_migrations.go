@@ -0,0 +1,318 @@
+package resources
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// sdkLastUpdated is the internal attribute that SDKv2 implementations of the resources kept in state
+var sdkLastUpdated = map[string]tftypes.Type{
+	"last_updated": tftypes.String,
+}
+
+// upgradeSdkState converts the state saved by SDKv2 implementation of the resource into the state of the
+// framework implementation: attributes that are absent in the framework schema (sdkOnlyAttributes) are dropped
+// and empty strings and collections saved by SDKv2 for unset optional attributes (nullIfEmpty) are converted into null values.
+func upgradeSdkState(
+	ctx context.Context,
+	req resource.UpgradeStateRequest,
+	resp *resource.UpgradeStateResponse,
+	stateModel tftypes.Object,
+	sdkOnlyAttributes map[string]tftypes.Type,
+	nullIfEmpty ...string) {
+
+	state, ok := readSdkState(req, resp, stateModel, sdkOnlyAttributes)
+	if !ok {
+		return
+	}
+
+	for _, k := range nullIfEmpty {
+		if isEmptyValue(state[k]) {
+			state[k] = tftypes.NewValue(stateModel.AttributeTypes[k], nil)
+		}
+	}
+
+	writeUpgradedState(resp, stateModel, state)
+}
+
+// readSdkState reads the attributes of the framework schema (stateModel) from the state saved by SDKv2
+func readSdkState(
+	req resource.UpgradeStateRequest,
+	resp *resource.UpgradeStateResponse,
+	stateModel tftypes.Object,
+	sdkOnlyAttributes map[string]tftypes.Type) (map[string]tftypes.Value, bool) {
+
+	priorModel := tftypes.Object{AttributeTypes: map[string]tftypes.Type{}}
+	for k, v := range stateModel.AttributeTypes {
+		priorModel.AttributeTypes[k] = v
+	}
+	for k, v := range sdkOnlyAttributes {
+		priorModel.AttributeTypes[k] = v
+	}
+
+	rawStateValue, err := req.RawState.Unmarshal(priorModel)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Unmarshal Prior State",
+			err.Error(),
+		)
+		return nil, false
+	}
+
+	var rawState map[string]tftypes.Value
+
+	if err := rawStateValue.As(&rawState); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Convert Prior State",
+			err.Error(),
+		)
+		return nil, false
+	}
+
+	state := make(map[string]tftypes.Value)
+	for k := range stateModel.AttributeTypes {
+		state[k] = rawState[k]
+	}
+	return state, true
+}
+
+func writeUpgradedState(resp *resource.UpgradeStateResponse, stateModel tftypes.Object, state map[string]tftypes.Value) {
+	dynamicValue, err := tfprotov6.NewDynamicValue(stateModel, tftypes.NewValue(stateModel, state))
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Upgraded State",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.DynamicValue = &dynamicValue
+}
+
+// isEmptyValue checks that the value is an empty string or an empty collection
+func isEmptyValue(value tftypes.Value) bool {
+	if !value.IsKnown() || value.IsNull() {
+		return false
+	}
+	var s string
+	if value.As(&s) == nil {
+		return s == ""
+	}
+	var items []tftypes.Value
+	if value.As(&items) == nil {
+		return len(items) == 0
+	}
+	return false
+}
+
+// nullIfZeroInBlock converts zero values of the primitive attributes of the SDKv2 block (a list of objects) into null values:
+// SDKv2 saved them for the attributes that are not set in the configuration
+func nullIfZeroInBlock(block tftypes.Value) tftypes.Value {
+	var items []tftypes.Value
+	if !block.IsKnown() || block.IsNull() || block.As(&items) != nil {
+		return block
+	}
+	blockType := block.Type().(tftypes.List)
+	itemType := blockType.ElementType.(tftypes.Object)
+
+	result := []tftypes.Value{}
+	for _, item := range items {
+		var attributes map[string]tftypes.Value
+		if err := item.As(&attributes); err != nil || attributes == nil {
+			result = append(result, item)
+			continue
+		}
+		for k, v := range attributes {
+			if isZeroValue(v) {
+				attributes[k] = tftypes.NewValue(itemType.AttributeTypes[k], nil)
+			}
+		}
+		result = append(result, tftypes.NewValue(itemType, attributes))
+	}
+	return tftypes.NewValue(blockType, result)
+}
+
+// isZeroValue checks that the value is an empty string, false or zero number
+func isZeroValue(value tftypes.Value) bool {
+	if !value.IsKnown() || value.IsNull() {
+		return false
+	}
+	switch {
+	case value.Type().Is(tftypes.String):
+		var s string
+		return value.As(&s) == nil && s == ""
+	case value.Type().Is(tftypes.Bool):
+		var b bool
+		return value.As(&b) == nil && !b
+	case value.Type().Is(tftypes.Number):
+		n := new(big.Float)
+		return value.As(&n) == nil && n.Sign() == 0
+	}
+	return false
+}
+
+func getGroupStateModel() tftypes.Object {
+	return tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"id":         tftypes.String,
+			"name":       tftypes.String,
+			"created_at": tftypes.String,
+		},
+	}
+}
+
+func getTeamStateModel() tftypes.Object {
+	return tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"id":          tftypes.String,
+			"name":        tftypes.String,
+			"description": tftypes.String,
+			"role":        tftypes.String,
+		},
+	}
+}
+
+func getTeamMembershipStateModel(membershipField string, membershipAttributes map[string]tftypes.Type) tftypes.Object {
+	return tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"id":      tftypes.String,
+			"team_id": tftypes.String,
+			membershipField: tftypes.Set{
+				ElementType: tftypes.Object{AttributeTypes: membershipAttributes},
+			},
+		},
+	}
+}
+
+func getGroupUsersStateModel() tftypes.Object {
+	return tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"id":            tftypes.String,
+			"group_id":      tftypes.String,
+			"authoritative": tftypes.Bool,
+			"user": tftypes.Set{
+				ElementType: tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"id":    tftypes.String,
+						"email": tftypes.String,
+						"role":  tftypes.String,
+					},
+				},
+			},
+		},
+	}
+}
+
+func getDbtProjectStateModel() tftypes.Object {
+	return tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"id":               tftypes.String,
+			"group_id":         tftypes.String,
+			"default_schema":   tftypes.String,
+			"dbt_version":      tftypes.String,
+			"environment_vars": tftypes.Set{ElementType: tftypes.String},
+			"target_name":      tftypes.String,
+			"threads":          tftypes.Number,
+			"type":             tftypes.String,
+			"status":           tftypes.String,
+			"created_at":       tftypes.String,
+			"created_by_id":    tftypes.String,
+			"public_key":       tftypes.String,
+			"ensure_readiness": tftypes.Bool,
+			"project_config": tftypes.List{
+				ElementType: tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"git_remote_url": tftypes.String,
+						"git_branch":     tftypes.String,
+						"folder_path":    tftypes.String,
+					},
+				},
+			},
+			"models": tftypes.Set{
+				ElementType: tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"id":         tftypes.String,
+						"model_name": tftypes.String,
+						"scheduled":  tftypes.Bool,
+					},
+				},
+			},
+			"timeouts": tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"create": tftypes.String,
+				},
+			},
+		},
+	}
+}
+
+func getDbtTransformationStateModel() tftypes.Object {
+	return tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"id":                tftypes.String,
+			"dbt_project_id":    tftypes.String,
+			"dbt_model_name":    tftypes.String,
+			"run_tests":         tftypes.Bool,
+			"paused":            tftypes.Bool,
+			"dbt_model_id":      tftypes.String,
+			"output_model_name": tftypes.String,
+			"created_at":        tftypes.String,
+			"connector_ids":     tftypes.Set{ElementType: tftypes.String},
+			"model_ids":         tftypes.Set{ElementType: tftypes.String},
+			"schedule": tftypes.List{
+				ElementType: tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"schedule_type": tftypes.String,
+						"days_of_week":  tftypes.Set{ElementType: tftypes.String},
+						"interval":      tftypes.Number,
+						"time_of_day":   tftypes.String,
+					},
+				},
+			},
+			"timeouts": tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"create": tftypes.String,
+				},
+			},
+		},
+	}
+}
+
+func getExternalLoggingStateModel() tftypes.Object {
+	return tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"id":              tftypes.String,
+			"group_id":        tftypes.String,
+			"service":         tftypes.String,
+			"enabled":         tftypes.Bool,
+			"run_setup_tests": tftypes.Bool,
+			"config": tftypes.List{
+				ElementType: tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"workspace_id":   tftypes.String,
+						"primary_key":    tftypes.String,
+						"log_group_name": tftypes.String,
+						"role_arn":       tftypes.String,
+						"external_id":    tftypes.String,
+						"region":         tftypes.String,
+						"api_key":        tftypes.String,
+						"sub_domain":     tftypes.String,
+						"host":           tftypes.String,
+						"hostname":       tftypes.String,
+						"port":           tftypes.Number,
+						"channel":        tftypes.String,
+						"enable_ssl":     tftypes.Bool,
+						"token":          tftypes.String,
+						"project_id":     tftypes.String,
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,362 @@
+package resources_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/resources"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func upgradeSdkStateForTest(t *testing.T, r resource.Resource, rawState string, stateType tftypes.Object) map[string]tftypes.Value {
+	ctx := context.Background()
+	upgrader := r.(resource.ResourceWithUpgradeState).UpgradeState(ctx)[0]
+
+	resp := &resource.UpgradeStateResponse{}
+	upgrader.StateUpgrader(ctx, resource.UpgradeStateRequest{
+		RawState: &tfprotov6.RawState{JSON: []byte(rawState)},
+	}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected upgrade error: %v", resp.Diagnostics)
+	}
+
+	value, err := resp.DynamicValue.Unmarshal(stateType)
+	if err != nil {
+		t.Fatalf("unable to unmarshal upgraded state: %v", err)
+	}
+
+	var result map[string]tftypes.Value
+	if err := value.As(&result); err != nil {
+		t.Fatalf("unable to convert upgraded state: %v", err)
+	}
+	return result
+}
+
+func TestGroupUsersSdkStateUpgrade(t *testing.T) {
+	userType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"id":    tftypes.String,
+		"email": tftypes.String,
+		"role":  tftypes.String,
+	}}
+	stateType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"id":            tftypes.String,
+		"group_id":      tftypes.String,
+		"authoritative": tftypes.Bool,
+		"user":          tftypes.Set{ElementType: userType},
+	}}
+
+	state := upgradeSdkStateForTest(t, resources.GroupUsers(), `{
+		"id": "group_id",
+		"group_id": "group_id",
+		"last_updated": "Monday, 02-Jan-06 15:04:05 UTC",
+		"user": [{"id": "user_id", "email": "user@domain", "role": "Destination Administrator"}]
+	}`, stateType)
+
+	if _, ok := state["last_updated"]; ok {
+		t.Errorf("last_updated should be removed from state")
+	}
+	if !state["group_id"].Equal(tftypes.NewValue(tftypes.String, "group_id")) {
+		t.Errorf("unexpected group_id: %v", state["group_id"])
+	}
+	// SDKv2 resource always managed all the users of the group: null keeps the authoritative default
+	if !state["authoritative"].IsNull() {
+		t.Errorf("authoritative should be null for SDKv2 state, got %v", state["authoritative"])
+	}
+
+	var users []tftypes.Value
+	if err := state["user"].As(&users); err != nil || len(users) != 1 {
+		t.Fatalf("unexpected users: %v", state["user"])
+	}
+	expected := tftypes.NewValue(userType, map[string]tftypes.Value{
+		"id":    tftypes.NewValue(tftypes.String, "user_id"),
+		"email": tftypes.NewValue(tftypes.String, "user@domain"),
+		"role":  tftypes.NewValue(tftypes.String, "Destination Administrator"),
+	})
+	if !users[0].Equal(expected) {
+		t.Errorf("unexpected user: %v", users[0])
+	}
+}
+
+func TestTeamSdkStateUpgrade(t *testing.T) {
+	stateType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"id":          tftypes.String,
+		"name":        tftypes.String,
+		"description": tftypes.String,
+		"role":        tftypes.String,
+	}}
+
+	state := upgradeSdkStateForTest(t, resources.Team(), `{
+		"id": "team_id",
+		"name": "team",
+		"description": "",
+		"role": "Account Reviewer"
+	}`, stateType)
+
+	if !state["description"].IsNull() {
+		t.Errorf("empty description should be converted to null, got %v", state["description"])
+	}
+	if !state["role"].Equal(tftypes.NewValue(tftypes.String, "Account Reviewer")) {
+		t.Errorf("unexpected role: %v", state["role"])
+	}
+}
+
+func TestTeamGroupMembershipSdkStateUpgrade(t *testing.T) {
+	groupType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"group_id":   tftypes.String,
+		"role":       tftypes.String,
+		"created_at": tftypes.String,
+	}}
+	stateType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"id":      tftypes.String,
+		"team_id": tftypes.String,
+		"group":   tftypes.Set{ElementType: groupType},
+	}}
+
+	state := upgradeSdkStateForTest(t, resources.TeamGroupMembership(), `{
+		"id": "team_id",
+		"team_id": "team_id",
+		"group": [{"group_id": "group_id", "role": "Destination Administrator", "created_at": "2024-01-01T00:00:00Z"}]
+	}`, stateType)
+
+	var groups []tftypes.Value
+	if err := state["group"].As(&groups); err != nil || len(groups) != 1 {
+		t.Fatalf("unexpected groups: %v", state["group"])
+	}
+	expected := tftypes.NewValue(groupType, map[string]tftypes.Value{
+		"group_id":   tftypes.NewValue(tftypes.String, "group_id"),
+		"role":       tftypes.NewValue(tftypes.String, "Destination Administrator"),
+		"created_at": tftypes.NewValue(tftypes.String, "2024-01-01T00:00:00Z"),
+	})
+	if !groups[0].Equal(expected) {
+		t.Errorf("unexpected group: %v", groups[0])
+	}
+}
+
+func TestDbtProjectSdkStateUpgrade(t *testing.T) {
+	stateType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"id":               tftypes.String,
+		"group_id":         tftypes.String,
+		"default_schema":   tftypes.String,
+		"dbt_version":      tftypes.String,
+		"environment_vars": tftypes.Set{ElementType: tftypes.String},
+		"target_name":      tftypes.String,
+		"threads":          tftypes.Number,
+		"type":             tftypes.String,
+		"status":           tftypes.String,
+		"created_at":       tftypes.String,
+		"created_by_id":    tftypes.String,
+		"public_key":       tftypes.String,
+		"ensure_readiness": tftypes.Bool,
+		"project_config": tftypes.List{ElementType: tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+			"git_remote_url": tftypes.String,
+			"git_branch":     tftypes.String,
+			"folder_path":    tftypes.String,
+		}}},
+		"models": tftypes.Set{ElementType: tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+			"id":         tftypes.String,
+			"model_name": tftypes.String,
+			"scheduled":  tftypes.Bool,
+		}}},
+		"timeouts": tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+			"create": tftypes.String,
+		}},
+	}}
+
+	state := upgradeSdkStateForTest(t, resources.DbtProject(), `{
+		"id": "project_id",
+		"group_id": "group_id",
+		"default_schema": "default_schema",
+		"dbt_version": "1.0.1",
+		"environment_vars": [],
+		"target_name": "",
+		"threads": 1,
+		"type": "GIT",
+		"status": "READY",
+		"created_at": "created_at",
+		"created_by_id": "user_id",
+		"public_key": "public_key",
+		"ensure_readiness": true,
+		"project_config": [{"git_remote_url": "git@github.com:fivetran/dbt_demo.git", "git_branch": "main", "folder_path": ""}],
+		"models": [{"id": "model_id", "model_name": "model_name", "scheduled": true}],
+		"timeouts": null
+	}`, stateType)
+
+	if !state["target_name"].IsNull() {
+		t.Errorf("empty target_name should be converted to null, got %v", state["target_name"])
+	}
+	if !state["environment_vars"].IsNull() {
+		t.Errorf("empty environment_vars should be converted to null, got %v", state["environment_vars"])
+	}
+	if !state["threads"].Equal(tftypes.NewValue(tftypes.Number, 1)) {
+		t.Errorf("unexpected threads: %v", state["threads"])
+	}
+
+	var models []tftypes.Value
+	if err := state["models"].As(&models); err != nil || len(models) != 1 {
+		t.Errorf("unexpected models: %v", state["models"])
+	}
+}
+
+func TestExternalLoggingSdkStateUpgrade(t *testing.T) {
+	configType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"workspace_id":   tftypes.String,
+		"primary_key":    tftypes.String,
+		"log_group_name": tftypes.String,
+		"role_arn":       tftypes.String,
+		"external_id":    tftypes.String,
+		"region":         tftypes.String,
+		"api_key":        tftypes.String,
+		"sub_domain":     tftypes.String,
+		"host":           tftypes.String,
+		"hostname":       tftypes.String,
+		"port":           tftypes.Number,
+		"channel":        tftypes.String,
+		"enable_ssl":     tftypes.Bool,
+		"token":          tftypes.String,
+		"project_id":     tftypes.String,
+	}}
+	stateType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"id":              tftypes.String,
+		"group_id":        tftypes.String,
+		"service":         tftypes.String,
+		"enabled":         tftypes.Bool,
+		"run_setup_tests": tftypes.Bool,
+		"config":          tftypes.List{ElementType: configType},
+	}}
+
+	state := upgradeSdkStateForTest(t, resources.ExternalLogging(), `{
+		"id": "log_id",
+		"group_id": "group_id",
+		"service": "azure_monitor_log",
+		"enabled": true,
+		"run_setup_tests": false,
+		"config": [{
+			"workspace_id": "workspace_id",
+			"primary_key": "primary_key",
+			"log_group_name": "",
+			"role_arn": "",
+			"external_id": "",
+			"region": "",
+			"api_key": "",
+			"sub_domain": "",
+			"host": "",
+			"hostname": "",
+			"port": 0,
+			"channel": "",
+			"enable_ssl": false,
+			"token": "",
+			"project_id": ""
+		}]
+	}`, stateType)
+
+	var config []tftypes.Value
+	if err := state["config"].As(&config); err != nil || len(config) != 1 {
+		t.Fatalf("unexpected config: %v", state["config"])
+	}
+	var attributes map[string]tftypes.Value
+	if err := config[0].As(&attributes); err != nil {
+		t.Fatalf("unable to convert config: %v", err)
+	}
+	if !attributes["workspace_id"].Equal(tftypes.NewValue(tftypes.String, "workspace_id")) {
+		t.Errorf("unexpected workspace_id: %v", attributes["workspace_id"])
+	}
+	if !attributes["primary_key"].Equal(tftypes.NewValue(tftypes.String, "primary_key")) {
+		t.Errorf("unexpected primary_key: %v", attributes["primary_key"])
+	}
+	for _, k := range []string{"region", "port", "enable_ssl", "token"} {
+		if !attributes[k].IsNull() {
+			t.Errorf("zero %v should be converted to null, got %v", k, attributes[k])
+		}
+	}
+}
+
+func TestGroupSdkStateUpgrade(t *testing.T) {
+	stateType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"id":         tftypes.String,
+		"name":       tftypes.String,
+		"created_at": tftypes.String,
+	}}
+
+	state := upgradeSdkStateForTest(t, resources.Group(), `{
+		"id": "group_id",
+		"name": "group_name",
+		"created_at": "2024-01-01T00:00:00Z",
+		"last_updated": "Monday, 02-Jan-06 15:04:05 UTC"
+	}`, stateType)
+
+	if _, ok := state["last_updated"]; ok {
+		t.Errorf("last_updated should be removed from state")
+	}
+	if !state["name"].Equal(tftypes.NewValue(tftypes.String, "group_name")) {
+		t.Errorf("unexpected name: %v", state["name"])
+	}
+	if !state["created_at"].Equal(tftypes.NewValue(tftypes.String, "2024-01-01T00:00:00Z")) {
+		t.Errorf("unexpected created_at: %v", state["created_at"])
+	}
+}
+
+func TestDbtTransformationSdkStateUpgrade(t *testing.T) {
+	scheduleType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"schedule_type": tftypes.String,
+		"days_of_week":  tftypes.Set{ElementType: tftypes.String},
+		"interval":      tftypes.Number,
+		"time_of_day":   tftypes.String,
+	}}
+	stateType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"id":                tftypes.String,
+		"dbt_project_id":    tftypes.String,
+		"dbt_model_name":    tftypes.String,
+		"run_tests":         tftypes.Bool,
+		"paused":            tftypes.Bool,
+		"dbt_model_id":      tftypes.String,
+		"output_model_name": tftypes.String,
+		"created_at":        tftypes.String,
+		"connector_ids":     tftypes.Set{ElementType: tftypes.String},
+		"model_ids":         tftypes.Set{ElementType: tftypes.String},
+		"schedule":          tftypes.List{ElementType: scheduleType},
+		"timeouts": tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+			"create": tftypes.String,
+		}},
+	}}
+
+	state := upgradeSdkStateForTest(t, resources.DbtTransformation(), `{
+		"id": "transformation_id",
+		"dbt_project_id": "project_id",
+		"dbt_model_name": "model_name",
+		"run_tests": false,
+		"paused": true,
+		"dbt_model_id": "model_id",
+		"output_model_name": "output_model_name",
+		"created_at": "2024-01-01T00:00:00Z",
+		"connector_ids": ["connector_id"],
+		"model_ids": [],
+		"schedule": [{"schedule_type": "TIME_OF_DAY", "days_of_week": ["MONDAY"], "interval": 0, "time_of_day": "12:00"}],
+		"timeouts": null
+	}`, stateType)
+
+	if !state["paused"].Equal(tftypes.NewValue(tftypes.Bool, true)) {
+		t.Errorf("unexpected paused: %v", state["paused"])
+	}
+	if !state["dbt_model_id"].Equal(tftypes.NewValue(tftypes.String, "model_id")) {
+		t.Errorf("unexpected dbt_model_id: %v", state["dbt_model_id"])
+	}
+
+	var schedule []tftypes.Value
+	if err := state["schedule"].As(&schedule); err != nil || len(schedule) != 1 {
+		t.Fatalf("unexpected schedule: %v", state["schedule"])
+	}
+	expected := tftypes.NewValue(scheduleType, map[string]tftypes.Value{
+		"schedule_type": tftypes.NewValue(tftypes.String, "TIME_OF_DAY"),
+		"days_of_week": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{
+			tftypes.NewValue(tftypes.String, "MONDAY"),
+		}),
+		"interval":    tftypes.NewValue(tftypes.Number, 0),
+		"time_of_day": tftypes.NewValue(tftypes.String, "12:00"),
+	})
+	if !schedule[0].Equal(expected) {
+		t.Errorf("unexpected schedule: %v", schedule[0])
+	}
+}
@@ -0,0 +1,19 @@
+package resources
+
+import (
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core"
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core/model"
+	fivetranSchema "github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+func TeamConnectorAccess() resource.Resource {
+	return &membership{
+		typeName:    "_team_connector_access",
+		title:       "Team Connector Access",
+		memberField: "connector_id",
+		attributes:  fivetranSchema.TeamConnectorAccess().GetResourceSchema,
+		api:         core.TeamConnectorMemberships,
+		newModel:    func() model.Membership { return &model.TeamConnectorAccess{} },
+	}
+}
@@ -0,0 +1,28 @@
+package resources
+
+import (
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core"
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core/model"
+	fivetranSchema "github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TeamConnectorMembership() resource.Resource {
+	return &memberships{
+		typeName:   "_team_connector_membership",
+		title:      "Team Connector Membership",
+		ownerField: "team_id",
+		attributes: fivetranSchema.TeamMembership().GetResourceSchema,
+		blocks:     fivetranSchema.TeamConnectorMembershipBlocks,
+		api:        core.TeamConnectorMemberships,
+		newModel:   func() model.Memberships { return &model.TeamConnectorMembership{} },
+		sdkStateModel: func() tftypes.Object {
+			return getTeamMembershipStateModel("connector", map[string]tftypes.Type{
+				"connector_id": tftypes.String,
+				"role":         tftypes.String,
+				"created_at":   tftypes.String,
+			})
+		},
+	}
+}
@@ -0,0 +1,19 @@
+package resources
+
+import (
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core"
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core/model"
+	fivetranSchema "github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+func TeamGroupAccess() resource.Resource {
+	return &membership{
+		typeName:    "_team_group_access",
+		title:       "Team Group Access",
+		memberField: "group_id",
+		attributes:  fivetranSchema.TeamGroupAccess().GetResourceSchema,
+		api:         core.TeamGroupMemberships,
+		newModel:    func() model.Membership { return &model.TeamGroupAccess{} },
+	}
+}
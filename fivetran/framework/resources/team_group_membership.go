@@ -0,0 +1,28 @@
+package resources
+
+import (
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core"
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core/model"
+	fivetranSchema "github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TeamGroupMembership() resource.Resource {
+	return &memberships{
+		typeName:   "_team_group_membership",
+		title:      "Team Group Membership",
+		ownerField: "team_id",
+		attributes: fivetranSchema.TeamMembership().GetResourceSchema,
+		blocks:     fivetranSchema.TeamGroupMembershipBlocks,
+		api:        core.TeamGroupMemberships,
+		newModel:   func() model.Memberships { return &model.TeamGroupMembership{} },
+		sdkStateModel: func() tftypes.Object {
+			return getTeamMembershipStateModel("group", map[string]tftypes.Type{
+				"group_id":   tftypes.String,
+				"role":       tftypes.String,
+				"created_at": tftypes.String,
+			})
+		},
+	}
+}
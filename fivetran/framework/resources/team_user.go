@@ -0,0 +1,19 @@
+package resources
+
+import (
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core"
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core/model"
+	fivetranSchema "github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+func TeamUser() resource.Resource {
+	return &membership{
+		typeName:    "_team_user",
+		title:       "Team User",
+		memberField: "user_id",
+		attributes:  fivetranSchema.TeamUser().GetResourceSchema,
+		api:         core.TeamUserMemberships,
+		newModel:    func() model.Membership { return &model.TeamUser{} },
+	}
+}
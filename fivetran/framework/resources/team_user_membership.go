@@ -0,0 +1,27 @@
+package resources
+
+import (
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core"
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core/model"
+	fivetranSchema "github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TeamUserMembership() resource.Resource {
+	return &memberships{
+		typeName:   "_team_user_membership",
+		title:      "Team User Membership",
+		ownerField: "team_id",
+		attributes: fivetranSchema.TeamMembership().GetResourceSchema,
+		blocks:     fivetranSchema.TeamUserMembershipBlocks,
+		api:        core.TeamUserMemberships,
+		newModel:   func() model.Memberships { return &model.TeamUserMembership{} },
+		sdkStateModel: func() tftypes.Object {
+			return getTeamMembershipStateModel("user", map[string]tftypes.Type{
+				"user_id": tftypes.String,
+				"role":    tftypes.String,
+			})
+		},
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core"
 	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core/model"
 	fivetranSchema "github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core/schema"
+	"github.com/fivetran/terraform-provider-fivetran/modules/helpers"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -107,6 +108,11 @@ func (r *user) Read(ctx context.Context, req resource.ReadRequest, resp *resourc
 	userResponse, err := r.GetClient().NewUserDetails().UserID(data.ID.ValueString()).Do(ctx)
 
 	if err != nil {
+		// If the resource does not exist (404), inform Terraform.
+		if helpers.IsNotFound(userResponse.Code, err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Unable to Read User Resource.",
 			fmt.Sprintf("%v; code: %v; message: %v", err, userResponse.Code, userResponse.Message),
@@ -0,0 +1,20 @@
+package resources
+
+import (
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core"
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core/model"
+	fivetranSchema "github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+func UserConnectorMembership() resource.Resource {
+	return &memberships{
+		typeName:   "_user_connector_membership",
+		title:      "User Connector Membership",
+		ownerField: "user_id",
+		attributes: fivetranSchema.UserMembership().GetResourceSchema,
+		blocks:     fivetranSchema.UserConnectorMembershipBlocks,
+		api:        core.UserConnectorMemberships,
+		newModel:   func() model.Memberships { return &model.UserConnectorMembership{} },
+	}
+}
@@ -0,0 +1,127 @@
+package resources_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/fivetran/go-fivetran/tests/mock"
+	tfmock "github.com/fivetran/terraform-provider-fivetran/fivetran/tests/mock"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestResourceUserConnectorMembershipMock(t *testing.T) {
+	var postHandler *mock.Handler
+	var patchHandler *mock.Handler
+	var deleteHandler *mock.Handler
+	var memberships map[string]interface{}
+
+	step1 := resource.TestStep{
+		Config: `
+		resource "fivetran_user_connector_membership" "test_membership" {
+			provider = fivetran-provider
+
+			user_id = "user_id"
+
+			connector {
+				connector_id = "connector_id"
+				role = "Connector Reviewer"
+			}
+		}`,
+
+		Check: resource.ComposeAggregateTestCheckFunc(
+			func(s *terraform.State) error {
+				tfmock.AssertEqual(t, postHandler.Interactions, 1)
+				tfmock.AssertEqual(t, patchHandler.Interactions, 0)
+				return nil
+			},
+			resource.TestCheckResourceAttr("fivetran_user_connector_membership.test_membership", "id", "user_id"),
+			resource.TestCheckResourceAttr("fivetran_user_connector_membership.test_membership", "connector.#", "1"),
+			resource.TestCheckResourceAttr("fivetran_user_connector_membership.test_membership", "connector.0.role", "Connector Reviewer"),
+			resource.TestCheckResourceAttr("fivetran_user_connector_membership.test_membership", "connector.0.created_at", "2024-01-01T00:00:00Z"),
+		),
+	}
+
+	step2 := resource.TestStep{
+		Config: `
+		resource "fivetran_user_connector_membership" "test_membership" {
+			provider = fivetran-provider
+
+			user_id = "user_id"
+
+			connector {
+				connector_id = "connector_id"
+				role = "Connector Administrator"
+			}
+		}`,
+
+		Check: resource.ComposeAggregateTestCheckFunc(
+			func(s *terraform.State) error {
+				tfmock.AssertEqual(t, postHandler.Interactions, 1)
+				tfmock.AssertEqual(t, patchHandler.Interactions, 1)
+				return nil
+			},
+			resource.TestCheckResourceAttr("fivetran_user_connector_membership.test_membership", "connector.0.role", "Connector Administrator"),
+		),
+	}
+
+	resource.Test(
+		t,
+		resource.TestCase{
+			PreCheck: func() {
+				tfmock.MockClient().Reset()
+				memberships = map[string]interface{}{}
+
+				tfmock.MockClient().When(http.MethodGet, "/v1/users/user_id/connectors").ThenCall(
+					func(req *http.Request) (*http.Response, error) {
+						items := []interface{}{}
+						for _, v := range memberships {
+							items = append(items, v)
+						}
+						return tfmock.FivetranSuccessResponse(t, req, http.StatusOK, "Success",
+							map[string]interface{}{"items": items, "next_cursor": nil}), nil
+					},
+				)
+
+				postHandler = tfmock.MockClient().When(http.MethodPost, "/v1/users/user_id/connectors").ThenCall(
+					func(req *http.Request) (*http.Response, error) {
+						body := tfmock.RequestBodyToJson(t, req)
+						tfmock.AssertKeyExistsAndHasValue(t, body, "id", "connector_id")
+						tfmock.AssertKeyExistsAndHasValue(t, body, "role", "Connector Reviewer")
+						memberships["connector_id"] = map[string]interface{}{
+							"id":         "connector_id",
+							"role":       body["role"],
+							"created_at": "2024-01-01T00:00:00Z",
+						}
+						return tfmock.FivetranSuccessResponse(t, req, http.StatusCreated, "Connector membership has been created", memberships["connector_id"].(map[string]interface{})), nil
+					},
+				)
+
+				patchHandler = tfmock.MockClient().When(http.MethodPatch, "/v1/users/user_id/connectors/connector_id").ThenCall(
+					func(req *http.Request) (*http.Response, error) {
+						body := tfmock.RequestBodyToJson(t, req)
+						memberships["connector_id"].(map[string]interface{})["role"] = body["role"]
+						return tfmock.FivetranSuccessResponse(t, req, http.StatusOK, "Connector membership has been updated", nil), nil
+					},
+				)
+
+				deleteHandler = tfmock.MockClient().When(http.MethodDelete, "/v1/users/user_id/connectors/connector_id").ThenCall(
+					func(req *http.Request) (*http.Response, error) {
+						delete(memberships, "connector_id")
+						return tfmock.FivetranSuccessResponse(t, req, http.StatusOK, "Connector membership has been deleted", nil), nil
+					},
+				)
+			},
+			ProtoV6ProviderFactories: tfmock.ProtoV6ProviderFactories,
+			CheckDestroy: func(s *terraform.State) error {
+				tfmock.AssertEqual(t, deleteHandler.Interactions, 1)
+				tfmock.AssertEmpty(t, memberships)
+				return nil
+			},
+			Steps: []resource.TestStep{
+				step1,
+				step2,
+			},
+		},
+	)
+}
@@ -0,0 +1,20 @@
+package resources
+
+import (
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core"
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core/model"
+	fivetranSchema "github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+func UserGroupMembership() resource.Resource {
+	return &memberships{
+		typeName:   "_user_group_membership",
+		title:      "User Group Membership",
+		ownerField: "user_id",
+		attributes: fivetranSchema.UserMembership().GetResourceSchema,
+		blocks:     fivetranSchema.UserGroupMembershipBlocks,
+		api:        core.UserGroupMemberships,
+		newModel:   func() model.Memberships { return &model.UserGroupMembership{} },
+	}
+}
@@ -7,6 +7,7 @@ import (
     "github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core"
     "github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core/model"
     fivetranSchema "github.com/fivetran/terraform-provider-fivetran/fivetran/framework/core/schema"
+    "github.com/fivetran/terraform-provider-fivetran/modules/helpers"
     "github.com/hashicorp/terraform-plugin-framework/path"
     "github.com/hashicorp/terraform-plugin-framework/resource"
 )
@@ -148,6 +149,11 @@ func (r *webhook) Read(ctx context.Context, req resource.ReadRequest, resp *reso
     webhookResponse, err := r.GetClient().NewWebhookDetails().WebhookId(data.Id.ValueString()).Do(ctx)
 
     if err != nil {
+        // If the resource does not exist (404), inform Terraform.
+        if helpers.IsNotFound(webhookResponse.Code, err) {
+            resp.State.RemoveResource(ctx)
+            return
+        }
         resp.Diagnostics.AddError(
             "Unable to Read Webhook Resource.",
             fmt.Sprintf("%v; code: %v; message: %v", err, webhookResponse.Code, webhookResponse.Message),
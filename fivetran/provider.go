@@ -2,30 +2,26 @@ package fivetran
 
 import (
 	"context"
+	"net/http"
 
 	"github.com/fivetran/go-fivetran"
+	httputils "github.com/fivetran/go-fivetran/http_utils"
 	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework"
+	"github.com/fivetran/terraform-provider-fivetran/modules/helpers"
+	"github.com/fivetran/terraform-provider-fivetran/modules/httpclient"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 var limit = 1000 // REST API response objects limit per HTTP request
 
 func Provider() *schema.Provider {
 	var resourceMap = map[string]*schema.Resource{
-		"fivetran_group":                     resourceGroup(),
-		"fivetran_group_users":               resourceGroupUsers(),
-		"fivetran_dbt_transformation":        resourceDbtTransformation(),
-		"fivetran_dbt_project":               resourceDbtProject(),
-		"fivetran_external_logging":          resourceExternalLogging(),
-		"fivetran_team":                      resourceTeam(),
-		"fivetran_team_connector_membership": resourceTeamConnectorMembership(),
-		"fivetran_team_group_membership":     resourceTeamGroupMembership(),
-		"fivetran_team_user_membership":      resourceTeamUserMembership(),
-		"fivetran_connector_fingerprints":    resourceFingerprints(Connector),
-		"fivetran_destination_fingerprints":  resourceFingerprints(Destination),
-		"fivetran_connector_certificates":    resourceCertificates(Connector),
-		"fivetran_destination_certificates":  resourceCertificates(Destination),
+		"fivetran_connector_fingerprints":   resourceFingerprints(Connector),
+		"fivetran_destination_fingerprints": resourceFingerprints(Destination),
+		"fivetran_connector_certificates":   resourceCertificates(Connector),
+		"fivetran_destination_certificates": resourceCertificates(Destination),
 	}
 
 	var dataSourceMap = map[string]*schema.Resource{
@@ -33,13 +29,9 @@ func Provider() *schema.Provider {
 		"fivetran_group":                      dataSourceGroup(),
 		"fivetran_groups":                     dataSourceGroups(),
 		"fivetran_group_connectors":           dataSourceGroupConnectors(),
+		"fivetran_connectors":                 dataSourceConnectors(),
 		"fivetran_group_users":                dataSourceGroupUsers(),
 		"fivetran_connectors_metadata":        dataSourceConnectorsMetadata(),
-		"fivetran_dbt_transformation":         dataSourceDbtTransformation(),
-		"fivetran_dbt_project":                dataSourceDbtProject(),
-		"fivetran_dbt_projects":               dataSourceDbtProjects(),
-		"fivetran_dbt_models":                 dataSourceDbtModels(),
-		"fivetran_external_logging":           dataSourceExternalLogging(),
 		"fivetran_roles":                      dataSourceRoles(),
 		"fivetran_team":                       dataSourceTeam(),
 		"fivetran_teams":                      dataSourceTeams(),
@@ -57,6 +49,26 @@ func Provider() *schema.Provider {
 			"api_key":    {Type: schema.TypeString, Optional: true},
 			"api_secret": {Type: schema.TypeString, Optional: true, Sensitive: true},
 			"api_url":    {Type: schema.TypeString, Optional: true},
+			"max_retries": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      httpclient.DefaultMaxRetries,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"retry_min_wait": {Type: schema.TypeString, Optional: true},
+			"retry_max_wait": {Type: schema.TypeString, Optional: true},
+			"requests_per_second": {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				ValidateFunc: validation.FloatAtLeast(0),
+			},
+			"max_concurrent_requests": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			// field definitions are used by the resources of the framework provider only
+			"field_definitions_path": {Type: schema.TypeString, Optional: true},
 		},
 		ResourcesMap:         resourceMap,
 		DataSourcesMap:       dataSourceMap,
@@ -78,11 +90,34 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}
 		d.Set("api_url", apiUrl)
 	}
 
+	retryMinWait, err := httpclient.ParseWait(d.Get("retry_min_wait").(string), httpclient.DefaultRetryMinWait)
+	if err != nil {
+		return nil, helpers.NewDiagAppend(diag.Diagnostics{}, diag.Error, "invalid retry_min_wait value", err.Error())
+	}
+
+	retryMaxWait, err := httpclient.ParseWait(d.Get("retry_max_wait").(string), httpclient.DefaultRetryMaxWait)
+	if err != nil {
+		return nil, helpers.NewDiagAppend(diag.Diagnostics{}, diag.Error, "invalid retry_max_wait value", err.Error())
+	}
+
 	fivetranClient := fivetran.New(d.Get("api_key").(string), d.Get("api_secret").(string))
 	if d.Get("api_url") != "" {
 		fivetranClient.BaseURL(d.Get("api_url").(string))
 	}
 
+	var httpClient httputils.HttpClient = &http.Client{}
+
+	requestsPerSecond := d.Get("requests_per_second").(float64)
+	maxConcurrentRequests := d.Get("max_concurrent_requests").(int)
+	if requestsPerSecond > 0 || maxConcurrentRequests > 0 {
+		// The limiter is shared with framework provider to limit all the requests of the plugin
+		httpClient = httpclient.NewRateLimitClient(httpClient, httpclient.SharedRateLimiter(requestsPerSecond, maxConcurrentRequests))
+	}
+
+	// Rate limits are handled by retry client along with server errors
+	fivetranClient.SetHttpClient(httpclient.NewRetryClient(httpClient, d.Get("max_retries").(int), retryMinWait, retryMaxWait))
+	fivetranClient.SetHandleRateLimits(false)
+
 	fivetranClient.CustomUserAgent("terraform-provider-fivetran/" + framework.Version)
 	return fivetranClient, diag.Diagnostics{}
 }
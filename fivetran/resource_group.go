@@ -1,138 +0,0 @@
-package fivetran
-
-import (
-	"context"
-	"fmt"
-	"time"
-
-	"github.com/fivetran/go-fivetran"
-	"github.com/fivetran/terraform-provider-fivetran/modules/helpers"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-)
-
-func resourceGroup() *schema.Resource {
-	return &schema.Resource{
-		CreateContext: resourceGroupCreate,
-		ReadContext:   resourceGroupRead,
-		UpdateContext: resourceGroupUpdate,
-		DeleteContext: resourceGroupDelete,
-		Importer:      &schema.ResourceImporter{StateContext: schema.ImportStatePassthroughContext},
-		Schema: map[string]*schema.Schema{
-			"id": {
-				Type:        schema.TypeString,
-				Computed:    true,
-				Description: "The unique identifier for the group within the Fivetran system.",
-			},
-			"name": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The name of the group within your account.",
-			},
-			"created_at": {
-				Type:        schema.TypeString,
-				Computed:    true,
-				Description: "The timestamp of when the group was created in your account.",
-			},
-			"last_updated": {
-				Type:        schema.TypeString,
-				Computed:    true,
-				Description: "",
-			}, // internal
-		},
-	}
-}
-
-func resourceGroupCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	var diags diag.Diagnostics
-	client := m.(*fivetran.Client)
-	svc := client.NewGroupCreate()
-
-	resp, err := svc.Name(d.Get("name").(string)).Do(ctx)
-	if err != nil {
-		return helpers.NewDiagAppend(diags, diag.Error, "create error", fmt.Sprintf("%v; code: %v; message: %v", err, resp.Code, resp.Message))
-	}
-
-	d.SetId(resp.Data.ID)
-	resourceGroupRead(ctx, d, m)
-
-	return diags
-}
-
-func resourceGroupRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	var diags diag.Diagnostics
-	client := m.(*fivetran.Client)
-	svc := client.NewGroupDetails()
-
-	groupID := d.Get("id").(string)
-	svc.GroupID(groupID)
-
-	resp, err := svc.Do(ctx)
-	if err != nil {
-		// If the resource does not exist (404), inform Terraform. We want to immediately
-		// return here to prevent further processing.
-		if resp.Code == "404" {
-			d.SetId("")
-			return nil
-		}
-		return helpers.NewDiagAppend(diags, diag.Error, "read error", fmt.Sprintf("%v; code: %v; message: %v", err, resp.Code, resp.Message))
-	}
-
-	// msi stands for Map String Interface
-	msi := make(map[string]interface{})
-	msi["id"] = resp.Data.ID
-	msi["name"] = resp.Data.Name
-	msi["created_at"] = resp.Data.CreatedAt.String()
-	for k, v := range msi {
-		if err := d.Set(k, v); err != nil {
-			return helpers.NewDiagAppend(diags, diag.Error, "set error", fmt.Sprint(err))
-		}
-	}
-
-	return diags
-}
-
-func resourceGroupUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	var diags diag.Diagnostics
-	client := m.(*fivetran.Client)
-	svc := client.NewGroupModify()
-	var change bool
-
-	groupID := d.Get("id").(string)
-	svc.GroupID(groupID)
-
-	if d.HasChange("name") {
-		svc.Name(d.Get("name").(string))
-		change = true
-	}
-
-	if change {
-		resp, err := svc.Do(ctx)
-		if err != nil {
-			// resourceGroupRead here makes sure the state is updated after a NewGroupModify error.
-			diags = resourceGroupRead(ctx, d, m)
-			return helpers.NewDiagAppend(diags, diag.Error, "update error", fmt.Sprintf("%v; code: %v; message: %v", err, resp.Code, resp.Message))
-		}
-	}
-
-	if err := d.Set("last_updated", time.Now().Format(time.RFC850)); err != nil {
-		return helpers.NewDiagAppend(diags, diag.Error, "set error", fmt.Sprint(err))
-	}
-
-	return resourceGroupRead(ctx, d, m)
-}
-
-func resourceGroupDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	var diags diag.Diagnostics
-	client := m.(*fivetran.Client)
-	svc := client.NewGroupDelete()
-
-	resp, err := svc.GroupID(d.Get("id").(string)).Do(ctx)
-	if err != nil {
-		return helpers.NewDiagAppend(diags, diag.Error, "delete error", fmt.Sprintf("%v; code: %v; message: %v", err, resp.Code, resp.Message))
-	}
-
-	d.SetId("")
-
-	return diags
-}
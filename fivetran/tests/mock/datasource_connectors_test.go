@@ -0,0 +1,226 @@
+package mock
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/fivetran/go-fivetran/tests/mock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var (
+	connectorsDataSourceMockGroupsHandler    *mock.Handler
+	connectorsDataSourceMockGroup1GetHandler *mock.Handler
+	connectorsDataSourceMockGroup2GetHandler *mock.Handler
+)
+
+const (
+	connectorsGroupsMappingResponse = `
+	{
+		"items": [
+			{
+				"id": "group_1",
+				"name": "Group_1",
+				"created_at": "2018-12-20T11:59:35.089589Z"
+			},
+			{
+				"id": "group_2",
+				"name": "Group_2",
+				"created_at": "2018-12-20T11:59:35.089589Z"
+			}
+		],
+		"next_cursor": null
+	}
+	`
+
+	connectorsGroup1MappingResponse = `
+	{
+		"items": [
+			{
+				"id": "salesforce_1",
+				"group_id": "group_1",
+				"service": "salesforce",
+				"service_version": 1,
+				"schema": "salesforce_main",
+				"connected_by": "concerning_batch",
+				"created_at": "2018-07-21T22:55:21.724201Z",
+				"succeeded_at": "2018-12-26T17:58:18.245Z",
+				"failed_at": "2018-08-24T15:24:58.872491Z",
+				"sync_frequency": 60,
+				"paused": false,
+				"status": {
+					"setup_state": "connected",
+					"sync_state": "scheduled",
+					"update_state": "on_schedule",
+					"is_historical_sync": false,
+					"tasks": [],
+					"warnings": []
+				}
+			},
+			{
+				"id": "salesforce_2",
+				"group_id": "group_1",
+				"service": "salesforce",
+				"service_version": 1,
+				"schema": "salesforce_paused",
+				"connected_by": "concerning_batch",
+				"created_at": "2018-07-21T22:55:21.724201Z",
+				"succeeded_at": "2018-12-26T17:58:18.245Z",
+				"failed_at": "2018-08-24T15:24:58.872491Z",
+				"sync_frequency": 60,
+				"paused": true,
+				"status": {
+					"setup_state": "connected",
+					"sync_state": "paused",
+					"update_state": "delayed",
+					"is_historical_sync": false,
+					"tasks": [],
+					"warnings": []
+				}
+			}
+		],
+		"next_cursor": null
+	}
+	`
+
+	connectorsGroup2MappingResponse = `
+	{
+		"items": [
+			{
+				"id": "salesforce_3",
+				"group_id": "group_2",
+				"service": "salesforce",
+				"service_version": 1,
+				"schema": "sf_other",
+				"connected_by": "concerning_batch",
+				"created_at": "2018-07-21T22:55:21.724201Z",
+				"succeeded_at": "2018-12-26T17:58:18.245Z",
+				"failed_at": "2018-08-24T15:24:58.872491Z",
+				"sync_frequency": 60,
+				"paused": false,
+				"status": {
+					"setup_state": "connected",
+					"sync_state": "scheduled",
+					"update_state": "on_schedule",
+					"is_historical_sync": false,
+					"tasks": [],
+					"warnings": []
+				}
+			},
+			{
+				"id": "google_ads_1",
+				"group_id": "group_2",
+				"service": "google_ads",
+				"service_version": 1,
+				"schema": "salesforce_like_name",
+				"connected_by": "concerning_batch",
+				"created_at": "2018-07-21T22:55:21.724201Z",
+				"succeeded_at": "2018-12-26T17:58:18.245Z",
+				"failed_at": "2018-08-24T15:24:58.872491Z",
+				"sync_frequency": 60,
+				"paused": false,
+				"status": {
+					"setup_state": "broken",
+					"sync_state": "scheduled",
+					"update_state": "on_schedule",
+					"is_historical_sync": false,
+					"tasks": [],
+					"warnings": []
+				}
+			}
+		],
+		"next_cursor": null
+	}
+	`
+)
+
+func setupMockClientConnectorsDataSourceConfigMapping(t *testing.T) {
+	mockClient.Reset()
+
+	connectorsDataSourceMockGroupsHandler = mockClient.When(http.MethodGet, "/v1/groups").ThenCall(
+		func(req *http.Request) (*http.Response, error) {
+			return fivetranSuccessResponse(t, req, http.StatusOK, "Success", createMapFromJsonString(t, connectorsGroupsMappingResponse)), nil
+		},
+	)
+
+	connectorsDataSourceMockGroup1GetHandler = mockClient.When(http.MethodGet, "/v1/groups/group_1/connectors").ThenCall(
+		func(req *http.Request) (*http.Response, error) {
+			return fivetranSuccessResponse(t, req, http.StatusOK, "Success", createMapFromJsonString(t, connectorsGroup1MappingResponse)), nil
+		},
+	)
+
+	connectorsDataSourceMockGroup2GetHandler = mockClient.When(http.MethodGet, "/v1/groups/group_2/connectors").ThenCall(
+		func(req *http.Request) (*http.Response, error) {
+			return fivetranSuccessResponse(t, req, http.StatusOK, "Success", createMapFromJsonString(t, connectorsGroup2MappingResponse)), nil
+		},
+	)
+}
+
+func TestDataSourceConnectorsMappingMock(t *testing.T) {
+	step1 := resource.TestStep{
+		Config: `
+		data "fivetran_connectors" "all" {
+			provider = fivetran-provider
+		}
+
+		data "fivetran_connectors" "filtered" {
+			provider = fivetran-provider
+			service = "salesforce"
+			paused = false
+			name_regex = "^salesforce_"
+		}
+
+		data "fivetran_connectors" "group_filtered" {
+			provider = fivetran-provider
+			group_id = "group_2"
+			setup_state = "broken"
+		}
+
+		data "fivetran_connectors" "paused" {
+			provider = fivetran-provider
+			sync_state = "paused"
+		}`,
+
+		Check: resource.ComposeAggregateTestCheckFunc(
+			func(s *terraform.State) error {
+				// groups are listed by 3 data sources on plan and refresh
+				assertEqual(t, connectorsDataSourceMockGroupsHandler.Interactions, 6)
+				assertEqual(t, connectorsDataSourceMockGroup1GetHandler.Interactions, 6)
+				assertEqual(t, connectorsDataSourceMockGroup2GetHandler.Interactions, 8)
+				return nil
+			},
+			resource.TestCheckResourceAttr("data.fivetran_connectors.all", "connectors.#", "4"),
+
+			resource.TestCheckResourceAttr("data.fivetran_connectors.filtered", "connectors.#", "1"),
+			resource.TestCheckResourceAttr("data.fivetran_connectors.filtered", "connectors.0.id", "salesforce_1"),
+			resource.TestCheckResourceAttr("data.fivetran_connectors.filtered", "connectors.0.group_id", "group_1"),
+			resource.TestCheckResourceAttr("data.fivetran_connectors.filtered", "connectors.0.schema", "salesforce_main"),
+			resource.TestCheckResourceAttr("data.fivetran_connectors.filtered", "connectors.0.paused", "false"),
+			resource.TestCheckResourceAttr("data.fivetran_connectors.filtered", "connectors.0.status.0.setup_state", "connected"),
+
+			resource.TestCheckResourceAttr("data.fivetran_connectors.group_filtered", "connectors.#", "1"),
+			resource.TestCheckResourceAttr("data.fivetran_connectors.group_filtered", "connectors.0.id", "google_ads_1"),
+
+			resource.TestCheckResourceAttr("data.fivetran_connectors.paused", "connectors.#", "1"),
+			resource.TestCheckResourceAttr("data.fivetran_connectors.paused", "connectors.0.id", "salesforce_2"),
+			resource.TestCheckResourceAttr("data.fivetran_connectors.paused", "connectors.0.paused", "true"),
+		),
+	}
+
+	resource.Test(
+		t,
+		resource.TestCase{
+			PreCheck: func() {
+				setupMockClientConnectorsDataSourceConfigMapping(t)
+			},
+			ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+			CheckDestroy: func(s *terraform.State) error {
+				return nil
+			},
+			Steps: []resource.TestStep{
+				step1,
+			},
+		},
+	)
+}
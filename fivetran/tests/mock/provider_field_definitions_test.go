@@ -0,0 +1,153 @@
+package mock
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/fivetran/go-fivetran/tests/mock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var (
+	providerFieldDefinitionsPostHandler *mock.Handler
+	providerFieldDefinitionsData        map[string]interface{}
+)
+
+const providerFieldDefinitionsJson = `
+{
+	"config": {
+		"schema": {
+			"type": "string",
+			"description": {"custom_service": "Destination schema name."}
+		},
+		"port": {
+			"description": {"custom_service": "The port number."}
+		},
+		"custom_field": {
+			"type": "string",
+			"description": {"custom_service": "The field that is not known by the provider schema."}
+		}
+	}
+}
+`
+
+func setupMockClientProviderFieldDefinitions(t *testing.T) {
+	mockClient.Reset()
+	providerFieldDefinitionsData = nil
+
+	mockClient.When(http.MethodGet, "/v1/connectors/connector_id").ThenCall(
+		func(req *http.Request) (*http.Response, error) {
+			return fivetranSuccessResponse(t, req, http.StatusOK, "Success", providerFieldDefinitionsData), nil
+		},
+	)
+
+	providerFieldDefinitionsPostHandler = mockClient.When(http.MethodPost, "/v1/connectors").ThenCall(
+		func(req *http.Request) (*http.Response, error) {
+			body := requestBodyToJson(t, req)
+			assertKeyExistsAndHasValue(t, body, "service", "custom_service")
+			config := assertKeyExists(t, body, "config").(map[string]interface{})
+			assertKeyExistsAndHasValue(t, config, "schema", "custom_schema")
+			assertKeyExistsAndHasValue(t, config, "port", float64(5432))
+			assertKeyExistsAndHasValue(t, config, "custom_field", "custom_value")
+
+			providerFieldDefinitionsData = createMapFromJsonString(t, `
+			{
+				"id": "connector_id",
+				"group_id": "group_id",
+				"service": "custom_service",
+				"service_version": 1,
+				"schema": "custom_schema",
+				"paused": true,
+				"pause_after_trial": true,
+				"connected_by": "user_id",
+				"created_at": "2022-01-01T11:22:33.012345Z",
+				"succeeded_at": null,
+				"failed_at": null,
+				"sync_frequency": 5,
+				"schedule_type": "auto",
+				"status": {
+					"setup_state": "incomplete",
+					"sync_state": "paused",
+					"update_state": "on_schedule",
+					"is_historical_sync": true,
+					"tasks": [],
+					"warnings": []
+				},
+				"config": {
+					"port": 5432,
+					"custom_field": "custom_value"
+				}
+			}
+			`)
+			return fivetranSuccessResponse(t, req, http.StatusCreated, "Success", providerFieldDefinitionsData), nil
+		},
+	)
+
+	mockClient.When(http.MethodDelete, "/v1/connectors/connector_id").ThenCall(
+		func(req *http.Request) (*http.Response, error) {
+			return fivetranSuccessResponse(t, req, http.StatusOK, "Success", nil), nil
+		},
+	)
+}
+
+func TestProviderFieldDefinitionsMock(t *testing.T) {
+	fieldDefinitionsPath := filepath.Join(t.TempDir(), "fields.json")
+	if err := os.WriteFile(fieldDefinitionsPath, []byte(providerFieldDefinitionsJson), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	step1 := resource.TestStep{
+		Config: fmt.Sprintf(`
+		provider "fivetran-provider" {
+			field_definitions_path = "%v"
+		}
+
+		resource "fivetran_connector" "test_connector" {
+			provider = fivetran-provider
+
+			group_id = "group_id"
+			service  = "custom_service"
+
+			destination_schema {
+				name = "custom_schema"
+			}
+
+			config {
+				port = 5432
+			}
+
+			config_json = jsonencode({
+				custom_field = "custom_value"
+			})
+		}`, filepath.ToSlash(fieldDefinitionsPath)),
+
+		Check: resource.ComposeAggregateTestCheckFunc(
+			func(s *terraform.State) error {
+				assertEqual(t, providerFieldDefinitionsPostHandler.Interactions, 1)
+				return nil
+			},
+			resource.TestCheckResourceAttr("fivetran_connector.test_connector", "service", "custom_service"),
+			resource.TestCheckResourceAttr("fivetran_connector.test_connector", "config.port", "5432"),
+		),
+	}
+
+	resource.Test(
+		t,
+		resource.TestCase{
+			PreCheck: func() {
+				setupMockClientProviderFieldDefinitions(t)
+			},
+			ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+			CheckDestroy: func(s *terraform.State) error {
+				return nil
+			},
+			Steps: []resource.TestStep{
+				step1,
+			},
+		},
+	)
+}
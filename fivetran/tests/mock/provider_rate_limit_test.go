@@ -0,0 +1,104 @@
+package mock
+
+import (
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/fivetran/go-fivetran/tests/mock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var (
+	providerRateLimitGetHandler    *mock.Handler
+	providerRateLimitInFlight      int32
+	providerRateLimitMaxConcurrent int32
+)
+
+func setupMockClientProviderRateLimit(t *testing.T) {
+	mockClient.Reset()
+	providerRateLimitInFlight = 0
+	providerRateLimitMaxConcurrent = 0
+
+	providerRateLimitGetHandler = mockClient.WhenWc(http.MethodGet, "/v1/users/user_?").ThenCall(
+		func(req *http.Request) (*http.Response, error) {
+			inFlight := atomic.AddInt32(&providerRateLimitInFlight, 1)
+			defer atomic.AddInt32(&providerRateLimitInFlight, -1)
+
+			for {
+				current := atomic.LoadInt32(&providerRateLimitMaxConcurrent)
+				if inFlight <= current || atomic.CompareAndSwapInt32(&providerRateLimitMaxConcurrent, current, inFlight) {
+					break
+				}
+			}
+
+			// emulate slow API to let terraform send requests in parallel
+			time.Sleep(50 * time.Millisecond)
+
+			return fivetranSuccessResponse(t, req, http.StatusOK, "Success", createMapFromJsonString(t, `
+			{
+				"id": "user_id",
+				"email": "john@mycompany.com",
+				"given_name": "John",
+				"family_name": "White",
+				"verified": true,
+				"invited": false,
+				"picture": null,
+				"phone": null,
+				"role": "Account Reviewer",
+				"logged_in_at": "2019-01-03T08:44:45.369Z",
+				"created_at": "2018-01-15T11:00:27.329220Z",
+				"active": true
+			}
+			`)), nil
+		},
+	)
+}
+
+func TestProviderRateLimitMock(t *testing.T) {
+	step1 := resource.TestStep{
+		Config: `
+		provider "fivetran-provider" {
+			requests_per_second     = 100
+			max_concurrent_requests = 1
+		}
+
+		data "fivetran_user" "test_user_1" {
+			provider = fivetran-provider
+			id = "user_1"
+		}
+
+		data "fivetran_user" "test_user_2" {
+			provider = fivetran-provider
+			id = "user_2"
+		}
+
+		data "fivetran_user" "test_user_3" {
+			provider = fivetran-provider
+			id = "user_3"
+		}`,
+
+		Check: resource.ComposeAggregateTestCheckFunc(
+			func(s *terraform.State) error {
+				assertEqual(t, providerRateLimitGetHandler.Interactions, 6)
+				assertEqual(t, atomic.LoadInt32(&providerRateLimitMaxConcurrent), int32(1))
+				return nil
+			},
+		),
+	}
+
+	resource.Test(
+		t,
+		resource.TestCase{
+			PreCheck: func() {
+				setupMockClientProviderRateLimit(t)
+			},
+			ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				step1,
+			},
+		},
+	)
+}
@@ -0,0 +1,85 @@
+package mock
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/fivetran/go-fivetran/tests/mock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var (
+	providerRetryGetHandler *mock.Handler
+)
+
+func setupMockClientProviderRetry(t *testing.T) {
+	mockClient.Reset()
+
+	providerRetryGetHandler = mockClient.When(http.MethodGet, "/v1/users/user_id").ThenCall(
+		func(req *http.Request) (*http.Response, error) {
+			switch providerRetryGetHandler.Interactions {
+			case 1:
+				response := fivetranResponse(t, req, "TooManyRequests", http.StatusTooManyRequests, "Rate limit exceeded", nil)
+				response.Header.Set("Retry-After", "0")
+				return response, nil
+			case 2:
+				return fivetranResponse(t, req, "ServiceUnavailable", http.StatusServiceUnavailable, "Service unavailable", nil), nil
+			}
+			return fivetranSuccessResponse(t, req, http.StatusOK, "Success", createMapFromJsonString(t, `
+			{
+				"id": "user_id",
+				"email": "john@mycompany.com",
+				"given_name": "John",
+				"family_name": "White",
+				"verified": true,
+				"invited": false,
+				"picture": null,
+				"phone": null,
+				"role": "Account Reviewer",
+				"logged_in_at": "2019-01-03T08:44:45.369Z",
+				"created_at": "2018-01-15T11:00:27.329220Z",
+				"active": true
+			}
+			`)), nil
+		},
+	)
+}
+
+func TestProviderRetryMock(t *testing.T) {
+	step1 := resource.TestStep{
+		Config: `
+		provider "fivetran-provider" {
+			max_retries    = 2
+			retry_min_wait = "10ms"
+			retry_max_wait = "20ms"
+		}
+
+		data "fivetran_user" "test_user" {
+			provider = fivetran-provider
+			id = "user_id"
+		}`,
+
+		Check: resource.ComposeAggregateTestCheckFunc(
+			func(s *terraform.State) error {
+				// 429 and 503 responses retried, then the data source is read once again on refresh
+				assertEqual(t, providerRetryGetHandler.Interactions, 4)
+				return nil
+			},
+			resource.TestCheckResourceAttr("data.fivetran_user.test_user", "email", "john@mycompany.com"),
+		),
+	}
+
+	resource.Test(
+		t,
+		resource.TestCase{
+			PreCheck: func() {
+				setupMockClientProviderRetry(t)
+			},
+			ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				step1,
+			},
+		},
+	)
+}
@@ -147,6 +147,9 @@ func getTfConfigForFieldImpl(fieldName, service string, field common.ConfigField
 		if len(field.ItemFields) > 0 {
 			subFields := make([]string, 0)
 			for n, f := range field.ItemFields {
+				if !isSubFieldForService(f, service) {
+					continue
+				}
 				subFields = append(subFields, getTfConfigForFieldImpl(n, service, f))
 			}
 			subFieldsStr := strings.Join(subFields, "\n\t")
@@ -158,6 +161,15 @@ func getTfConfigForFieldImpl(fieldName, service string, field common.ConfigField
 	return ""
 }
 
+// isSubFieldForService returns false for sub-fields that belong only to other services
+func isSubFieldForService(field common.ConfigField, service string) bool {
+	if len(field.Description) == 0 {
+		return true
+	}
+	_, ok := field.Description[service]
+	return ok
+}
+
 func getJsonConfigForField(fieldName, service string) string {
 	if f, ok := common.GetConfigFieldsMap()[fieldName]; ok {
 		return getJsonConfigForFieldImpl(fieldName, service, f)
@@ -186,6 +198,9 @@ func getJsonConfigForFieldImpl(fieldName, service string, field common.ConfigFie
 		if len(field.ItemFields) > 0 {
 			subFields := make([]string, 0)
 			for n, f := range field.ItemFields {
+				if !isSubFieldForService(f, service) {
+					continue
+				}
 				subFields = append(subFields, getJsonConfigForFieldImpl(n, service, f))
 			}
 			subFieldsStr := strings.Join(subFields, ",\n\t")
@@ -1165,3 +1165,158 @@ func TestResourceLockedSchemaMock(t *testing.T) {
 		},
 	)
 }
+
+func TestResyncOnChangeMock(t *testing.T) {
+	var getHandler *mock.Handler
+	var patchHandler *mock.Handler
+	var table1ResyncHandler *mock.Handler
+	var table2ResyncHandler *mock.Handler
+	var schemaData map[string]interface{}
+
+	setupMockClientResyncOnChangeResource := func(t *testing.T) {
+		mockClient.Reset()
+		schemaData = createMapFromJsonString(t, `
+			{
+				"enable_new_by_default": true,
+				"schema_change_handling": "BLOCK_ALL",
+				"schemas": {
+					"schema_1": {
+						"name_in_destination": "schema_1",
+						"enabled": true,
+						"tables": {
+							"table_1": {
+								"name_in_destination": "table_1",
+								"enabled": true,
+								"sync_mode": "HISTORY",
+								"enabled_patch_settings": {
+									"allowed": true
+								}
+							},
+							"table_2": {
+								"name_in_destination": "table_2",
+								"enabled": true,
+								"sync_mode": "HISTORY",
+								"enabled_patch_settings": {
+									"allowed": true
+								}
+							}
+						}
+					}
+				}
+			}
+			`)
+
+		getHandler = mockClient.When(http.MethodGet, "/v1/connectors/connector_id/schemas").ThenCall(
+			func(req *http.Request) (*http.Response, error) {
+				return fivetranSuccessResponse(t, req, http.StatusOK, "Success", schemaData), nil
+			},
+		)
+
+		patchHandler = mockClient.When(http.MethodPatch, "/v1/connectors/connector_id/schemas").ThenCall(
+			func(req *http.Request) (*http.Response, error) {
+				body := requestBodyToJson(t, req)
+				patchedTables := body["schemas"].(map[string]interface{})["schema_1"].(map[string]interface{})["tables"].(map[string]interface{})
+				tables := schemaData["schemas"].(map[string]interface{})["schema_1"].(map[string]interface{})["tables"].(map[string]interface{})
+				for tName, tPatch := range patchedTables {
+					if syncMode, ok := tPatch.(map[string]interface{})["sync_mode"]; ok {
+						tables[tName].(map[string]interface{})["sync_mode"] = syncMode
+					}
+				}
+				return fivetranSuccessResponse(t, req, http.StatusOK, "Success", schemaData), nil
+			},
+		)
+
+		table1ResyncHandler = mockClient.When(http.MethodPost, "/v1/connectors/connector_id/schemas/schema_1/tables/table_1/resync").ThenCall(
+			func(req *http.Request) (*http.Response, error) {
+				return fivetranSuccessResponse(t, req, http.StatusOK, "Re-sync has been triggered successfully", nil), nil
+			},
+		)
+
+		table2ResyncHandler = mockClient.When(http.MethodPost, "/v1/connectors/connector_id/schemas/schema_1/tables/table_2/resync").ThenCall(
+			func(req *http.Request) (*http.Response, error) {
+				return fivetranSuccessResponse(t, req, http.StatusOK, "Re-sync has been triggered successfully", nil), nil
+			},
+		)
+	}
+
+	step1 := resource.TestStep{
+		Config: `
+			resource "fivetran_connector_schema_config" "test_schema" {
+				provider = fivetran-provider
+				connector_id = "connector_id"
+				schema_change_handling = "BLOCK_ALL"
+				schema {
+					name = "schema_1"
+					table {
+						name = "table_1"
+						sync_mode = "SOFT_DELETE"
+						resync_on_change = true
+					}
+					table {
+						name = "table_2"
+						sync_mode = "HISTORY"
+						resync_on_change = true
+					}
+				}
+			}`,
+
+		Check: resource.ComposeAggregateTestCheckFunc(
+			func(s *terraform.State) error {
+				assertEqual(t, getHandler.Interactions, 1)
+				assertEqual(t, patchHandler.Interactions, 1)
+				assertEqual(t, table1ResyncHandler.Interactions, 1) // sync_mode changed
+				assertEqual(t, table2ResyncHandler.Interactions, 0) // nothing changed
+				return nil
+			},
+			resource.TestCheckResourceAttr("fivetran_connector_schema_config.test_schema", "schema.0.table.0.resync_on_change", "true"),
+		),
+	}
+
+	step2 := resource.TestStep{
+		Config: `
+			resource "fivetran_connector_schema_config" "test_schema" {
+				provider = fivetran-provider
+				connector_id = "connector_id"
+				schema_change_handling = "BLOCK_ALL"
+				schema {
+					name = "schema_1"
+					table {
+						name = "table_1"
+						sync_mode = "SOFT_DELETE"
+					}
+					table {
+						name = "table_2"
+						sync_mode = "LIVE"
+					}
+				}
+			}`,
+
+		Check: resource.ComposeAggregateTestCheckFunc(
+			func(s *terraform.State) error {
+				assertEqual(t, patchHandler.Interactions, 2)
+				assertEqual(t, table1ResyncHandler.Interactions, 1)
+				assertEqual(t, table2ResyncHandler.Interactions, 0) // resync_on_change is not set
+				return nil
+			},
+		),
+	}
+
+	resource.Test(
+		t,
+		resource.TestCase{
+			PreCheck: func() {
+				setupMockClientResyncOnChangeResource(t)
+			},
+			ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+			CheckDestroy: func(s *terraform.State) error {
+				// there is no possibility to destroy schema config - it alsways exists within the connector
+				return nil
+			},
+
+			Steps: []resource.TestStep{
+				step1,
+				step2,
+			},
+		},
+	)
+}
@@ -1,10 +1,14 @@
 package mock
 
 import (
+	"fmt"
 	"net/http"
+	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/fivetran/go-fivetran/tests/mock"
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/common"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
@@ -145,9 +149,9 @@ const (
 	{
 		"id": "connector_id",
         "group_id": "group_id",
-        "service": "google_sheets",
+        "service": "%v",
         "service_version": 1,
-        "schema": "google_sheets_schema.table",
+        "schema": "%v",
         "paused": true,
         "pause_after_trial": true,
         "connected_by": "user_id",
@@ -175,47 +179,7 @@ const (
             "status": "FAILED",
             "message": "Invalid login credentials"
         }],
-        "config": {
-			"packed_mode_tables":["packed_mode_table_3", "packed_mode_table_2", "packed_mode_table_1"],
-			"properties":["property_2", "property_1"],
-			"primary_keys":["primary_key_2", "primary_key_1"],
-			"report_suites": ["value_2", "value_1"],
-			"elements": ["value_2", "value_1"],
-			"metrics": ["value_2", "value_1"],
-			"advertisables": ["value_2", "value_1"],
-			"dimensions": ["value_2", "value_1"],
-			"selected_exports": ["value_2", "value_1"],
-			"apps": ["value_2", "value_1"],
-			"sales_accounts": ["value_2", "value_1"],
-			"finance_accounts": ["value_2", "value_1"],
-			"projects": ["value_2", "value_1"],
-			"user_profiles": ["value_2", "value_1"],
-			"report_configuration_ids": ["value_2", "value_1"],
-			"accounts": ["value_2", "value_1"],
-			"fields": ["value_2", "value_1"],
-			"breakdowns": ["value_2", "value_1"],
-			"action_breakdowns": ["value_2", "value_1"],
-			"pages": ["value_2", "value_1"],
-			"repositories": ["value_2", "value_1"],
-			"dimension_attributes": ["value_2", "value_1"],
-			"columns": ["value_2", "value_1"],
-			"manager_accounts": ["value_2", "value_1"],
-			"profiles": ["value_2", "value_1"],
-			"site_urls": ["value_2", "value_1"],
-			"api_keys": ["value_2", "value_1"],
-			"advertisers_id": ["value_2", "value_1"],
-			"hosts": ["value_2", "value_1"],
-			"advertisers": ["value_2", "value_1"],
-			"organizations": ["value_2", "value_1"],
-			"account_ids": ["value_2", "value_1"],
-			"segments": ["value_2", "value_1"],
-			"schema_registry_urls": ["value_2", "value_1"],
-			"per_interaction_dimensions": ["value_2", "value_1"],
-			"partners": ["value_2", "value_1"],
-			"custom_floodlight_variables": ["value_2", "value_1"],
-			"conversion_dimensions": ["value_2", "value_1"],
-			"app_ids": ["value_2", "value_1"]
-		}
+        "config": %v
 	}
 	`
 
@@ -224,11 +188,10 @@ const (
 		provider = fivetran-provider
 
 		group_id = "group_id"
-		service = "google_sheets"
+		service = "%v"
 
 		destination_schema {
-			name = "google_sheets_schema"
-			table = "table"
+			%v
 		}
 
 		trust_certificates = false
@@ -236,53 +199,39 @@ const (
 		run_setup_tests = false
 
 		config {
-			packed_mode_tables = ["packed_mode_table_1", "packed_mode_table_2", "packed_mode_table_3"]
-			properties = ["property_1", "property_2"]
-			primary_keys = ["primary_key_1", "primary_key_2"]
-
-			app_ids = ["value_1", "value_2"]
-			conversion_dimensions = ["value_1", "value_2"]
-			custom_floodlight_variables = ["value_1", "value_2"]
-			partners = ["value_1", "value_2"]
-			per_interaction_dimensions = ["value_1", "value_2"]
-			schema_registry_urls = ["value_1", "value_2"]
-		    segments = ["value_1", "value_2"]
-
-			metrics = ["value_1", "value_2"]
-			advertisables = ["value_1", "value_2"]
-			dimensions = ["value_1", "value_2"]
-			selected_exports = ["value_1", "value_2"]
-			apps = ["value_1", "value_2"]
-			sales_accounts = ["value_1", "value_2"]
-			finance_accounts = ["value_1", "value_2"]
-			projects = ["value_1", "value_2"]
-			user_profiles = ["value_1", "value_2"]
-			report_configuration_ids = ["value_1", "value_2"]
-			accounts = ["value_1", "value_2"]
-			fields = ["value_1", "value_2"]
-			breakdowns = ["value_1", "value_2"]
-			action_breakdowns = ["value_1", "value_2"]
-			pages = ["value_1", "value_2"]
-			repositories = ["value_1", "value_2"]
-			dimension_attributes = ["value_1", "value_2"]
-			columns = ["value_1", "value_2"]
-			manager_accounts = ["value_1", "value_2"]
-			profiles = ["value_1", "value_2"]
-			site_urls = ["value_1", "value_2"]
-			api_keys = ["value_1", "value_2"]
-			advertisers_id = ["value_1", "value_2"]
-			hosts = ["value_1", "value_2"]
-			advertisers = ["value_1", "value_2"]
-			organizations = ["value_1", "value_2"]
-			account_ids = ["value_1", "value_2"]
-			elements = ["value_1", "value_2"]
-			report_suites = ["value_1", "value_2"]
+			%v
 		}
 	}
 	`
 )
 
-func setupMockClientConnectorResourceListMappingConfig(t *testing.T) {
+// list fields grouped by the services that support them
+var connectorListsMappingFields = map[string][]string{
+	"double_click_campaign_manager": {"conversion_dimensions", "custom_floodlight_variables", "per_interaction_dimensions", "metrics", "dimensions", "user_profiles", "report_configuration_ids"},
+	"facebook":                      {"accounts", "fields", "breakdowns", "action_breakdowns"},
+	"itunes_connect":                {"apps", "sales_accounts", "finance_accounts"},
+	"azure_cosmos_for_mongo":        {"packed_mode_tables", "hosts"},
+	"double_click_publishers":       {"dimension_attributes", "columns"},
+	"google_analytics":              {"segments", "profiles"},
+	"google_display_and_video_360":  {"partners", "advertisers"},
+	"adroll":                        {"advertisables"},
+	"anaplan":                       {"selected_exports"},
+	"apache_kafka":                  {"schema_registry_urls"},
+	"apple_search_ads":              {"organizations"},
+	"asana":                         {"projects"},
+	"facebook_pages":                {"pages"},
+	"github":                        {"repositories"},
+	"google_ads":                    {"manager_accounts"},
+	"google_analytics_4":            {"properties"},
+	"google_search_console":         {"site_urls"},
+	"mandrill":                      {"api_keys"},
+	"pendo":                         {"app_ids"},
+	"taboola":                       {"account_ids"},
+	"workday":                       {"primary_keys"},
+	"yahoo_gemini":                  {"advertisers_id"},
+}
+
+func setupMockClientConnectorResourceListMappingConfig(t *testing.T, responseJson string) {
 	mockClient.Reset()
 
 	connectorListsMockGetHandler = mockClient.When(http.MethodGet, "/v1/connectors/connector_id").ThenCall(
@@ -293,7 +242,7 @@ func setupMockClientConnectorResourceListMappingConfig(t *testing.T) {
 
 	connectorListsMockPostHandler = mockClient.When(http.MethodPost, "/v1/connectors").ThenCall(
 		func(req *http.Request) (*http.Response, error) {
-			connectorMockData = createMapFromJsonString(t, connectorConfigListsMappingResponse)
+			connectorMockData = createMapFromJsonString(t, responseJson)
 			return fivetranSuccessResponse(t, req, http.StatusCreated, "Success", connectorMockData), nil
 		},
 	)
@@ -545,8 +494,32 @@ func TestResourceConnectorEmptyConfigMock(t *testing.T) {
 }
 
 func TestResourceConnectorListsConfigMock(t *testing.T) {
+	for service, fields := range connectorListsMappingFields {
+		t.Run(service, func(t *testing.T) {
+			testResourceConnectorListsConfigMock(t, service, fields)
+		})
+	}
+}
+
+func testResourceConnectorListsConfigMock(t *testing.T, service string, fields []string) {
+	tfConfig := make([]string, 0, len(fields))
+	jsonConfig := make([]string, 0, len(fields))
+	for _, fieldName := range fields {
+		// upstream returns list items in different order
+		if common.GetFieldsForService(service)[fieldName].ItemType[service] == common.Integer {
+			tfConfig = append(tfConfig, fmt.Sprintf(`%v = ["1", "2"]`, fieldName))
+			jsonConfig = append(jsonConfig, fmt.Sprintf(`"%v": [2, 1]`, fieldName))
+		} else {
+			tfConfig = append(tfConfig, fmt.Sprintf(`%v = ["value_1", "value_2"]`, fieldName))
+			jsonConfig = append(jsonConfig, fmt.Sprintf(`"%v": ["value_2", "value_1"]`, fieldName))
+		}
+	}
+
 	step1 := resource.TestStep{
-		Config: connectorConfigListsMappingTfConfig,
+		Config: fmt.Sprintf(connectorConfigListsMappingTfConfig,
+			service,
+			getTfDestinationSchema(service),
+			strings.Join(tfConfig, "\n\t\t\t")),
 
 		Check: resource.ComposeAggregateTestCheckFunc(
 			func(s *terraform.State) error {
@@ -562,7 +535,11 @@ func TestResourceConnectorListsConfigMock(t *testing.T) {
 		t,
 		resource.TestCase{
 			PreCheck: func() {
-				setupMockClientConnectorResourceListMappingConfig(t)
+				setupMockClientConnectorResourceListMappingConfig(t,
+					fmt.Sprintf(connectorConfigListsMappingResponse,
+						service,
+						getJsonSchemaValue(service),
+						"{"+strings.Join(jsonConfig, ",")+"}"))
 			},
 			ProtoV6ProviderFactories: ProtoV6ProviderFactories,
 			CheckDestroy: func(s *terraform.State) error {
@@ -577,3 +554,75 @@ func TestResourceConnectorListsConfigMock(t *testing.T) {
 		},
 	)
 }
+
+func TestResourceConnectorImportBySchemaMock(t *testing.T) {
+	var groupConnectorsMockHandler *mock.Handler
+
+	tfConfig := fmt.Sprintf(connectorConfigListsMappingTfConfig,
+		"google_sheets",
+		getTfDestinationSchema("google_sheets"),
+		`sheet_id = "sheet_id"`)
+
+	resource.Test(
+		t,
+		resource.TestCase{
+			PreCheck: func() {
+				setupMockClientConnectorResourceListMappingConfig(t,
+					fmt.Sprintf(connectorConfigListsMappingResponse,
+						"google_sheets",
+						getJsonSchemaValue("google_sheets"),
+						`{"sheet_id": "sheet_id"}`))
+				groupConnectorsMockHandler = mockClient.When(http.MethodGet, "/v1/groups/group_id/connectors").ThenCall(
+					func(req *http.Request) (*http.Response, error) {
+						return fivetranSuccessResponse(t, req, http.StatusOK, "Success",
+							map[string]interface{}{
+								"items": []interface{}{
+									createMapFromJsonString(t, `{"id": "other_connector_id", "group_id": "group_id", "service": "google_sheets", "schema": "other_schema.table"}`),
+									connectorMockData,
+								},
+							}), nil
+					},
+				)
+			},
+			ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+			CheckDestroy: func(s *terraform.State) error {
+				assertEqual(t, connectorListsMockDelete.Interactions, 1)
+				return nil
+			},
+
+			Steps: []resource.TestStep{
+				{
+					Config: tfConfig,
+				},
+				{
+					Config:            tfConfig,
+					ResourceName:      "fivetran_connector.test_connector",
+					ImportState:       true,
+					ImportStateId:     "group_id/google_sheets.table",
+					ImportStateVerify: true,
+					ImportStateVerifyIgnore: []string{
+						"run_setup_tests",
+						"trust_certificates",
+						"trust_fingerprints",
+						"wait_for_setup",
+						"fail_on_setup_test_failure",
+						"setup_tests",
+						// config values are not read into state on import without local configuration
+						"config.sheet_id",
+					},
+					Check: func(s *terraform.State) error {
+						assertEqual(t, groupConnectorsMockHandler.Interactions, 1)
+						return nil
+					},
+				},
+				{
+					Config:        tfConfig,
+					ResourceName:  "fivetran_connector.test_connector",
+					ImportState:   true,
+					ImportStateId: "group_id/unknown_schema",
+					ExpectError:   regexp.MustCompile("Unable to find connector by import identifier `group_id/unknown_schema`"),
+				},
+			},
+		},
+	)
+}
@@ -239,3 +239,168 @@ func TestResourceGroupUsersMock(t *testing.T) {
 		},
 	)
 }
+
+func TestResourceGroupUsersNonAuthoritativeMock(t *testing.T) {
+	initialUsers := make([]interface{}, 0)
+
+	user := make(map[string]interface{})
+
+	user["id"] = "initial_user"
+	user["email"] = "initial_user@email"
+	user["role"] = "Some Role"
+
+	initialUsers = append(initialUsers, user)
+
+	step1 := resource.TestStep{
+		Config: `
+			resource "fivetran_group_users" "testgroup_users" {
+				provider = fivetran-provider
+
+				group_id = "group_id"
+				authoritative = false
+
+				user {
+					email = "email@user.domain"
+					role = "Destination Administrator"
+				}
+
+				user {
+					email = "email1@user.domain"
+					role = "Destination Administrator"
+				}
+			}`,
+
+		Check: resource.ComposeAggregateTestCheckFunc(
+			func(s *terraform.State) error {
+				assertEqual(t, groupPostUserHandler.Interactions, 2)
+				assertEqual(t, groupDeleteUserHandler.Interactions, 0)
+				assertEqual(t, len(groupUsersData), 3)
+				return nil
+			},
+			resource.TestCheckResourceAttr("fivetran_group_users.testgroup_users", "user.#", "2"),
+		),
+	}
+
+	step2 := resource.TestStep{
+		Config: `
+			resource "fivetran_group_users" "testgroup_users" {
+				provider = fivetran-provider
+
+				group_id = "group_id"
+				authoritative = false
+
+				user {
+					email = "email@user.domain"
+					role = "Destination Administrator"
+				}
+			}`,
+
+		Check: resource.ComposeAggregateTestCheckFunc(
+			func(s *terraform.State) error {
+				// only the user removed from the configuration is removed from the group
+				assertEqual(t, groupDeleteUserHandler.Interactions, 1)
+				assertEqual(t, len(groupUsersData), 2)
+				return nil
+			},
+			resource.TestCheckResourceAttr("fivetran_group_users.testgroup_users", "user.#", "1"),
+		),
+	}
+
+	resource.Test(
+		t,
+		resource.TestCase{
+			PreCheck: func() {
+				setupMockClientGroupUsersResource(t, initialUsers)
+			},
+			ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+			CheckDestroy: func(s *terraform.State) error {
+				assertEqual(t, groupDeleteUserHandler.Interactions, 2)
+				// the user not managed by the resource is kept
+				assertEqual(t, len(groupUsersData), 1)
+				return nil
+			},
+
+			Steps: []resource.TestStep{
+				step1,
+				step2,
+			},
+		},
+	)
+}
+
+func TestResourceGroupUserMock(t *testing.T) {
+	initialUsers := make([]interface{}, 0)
+
+	user := make(map[string]interface{})
+
+	user["id"] = "initial_user"
+	user["email"] = "initial_user@email"
+	user["role"] = "Some Role"
+
+	initialUsers = append(initialUsers, user)
+
+	step1 := resource.TestStep{
+		Config: `
+			resource "fivetran_group_user" "testgroup_user" {
+				provider = fivetran-provider
+
+				group_id = "group_id"
+				email = "email@user.domain"
+				role = "Destination Administrator"
+			}`,
+
+		Check: resource.ComposeAggregateTestCheckFunc(
+			func(s *terraform.State) error {
+				assertEqual(t, groupPostUserHandler.Interactions, 1)
+				assertEqual(t, groupDeleteUserHandler.Interactions, 0)
+				assertEqual(t, len(groupUsersData), 2)
+				return nil
+			},
+			resource.TestCheckResourceAttr("fivetran_group_user.testgroup_user", "id", "group_id:email@user.domain"),
+			resource.TestCheckResourceAttr("fivetran_group_user.testgroup_user", "user_id", "user_10"),
+			resource.TestCheckResourceAttr("fivetran_group_user.testgroup_user", "role", "Destination Administrator"),
+		),
+	}
+
+	step2 := resource.TestStep{
+		Config: `
+			resource "fivetran_group_user" "testgroup_user" {
+				provider = fivetran-provider
+
+				group_id = "group_id"
+				email = "email@user.domain"
+				role = "Destination Analyst"
+			}`,
+
+		Check: resource.ComposeAggregateTestCheckFunc(
+			func(s *terraform.State) error {
+				// role can't be modified, so the user is re-added to the group
+				assertEqual(t, groupPostUserHandler.Interactions, 2)
+				assertEqual(t, groupDeleteUserHandler.Interactions, 1)
+				assertEqual(t, len(groupUsersData), 2)
+				return nil
+			},
+			resource.TestCheckResourceAttr("fivetran_group_user.testgroup_user", "role", "Destination Analyst"),
+		),
+	}
+
+	resource.Test(
+		t,
+		resource.TestCase{
+			PreCheck: func() {
+				setupMockClientGroupUsersResource(t, initialUsers)
+			},
+			ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+			CheckDestroy: func(s *terraform.State) error {
+				assertEqual(t, groupDeleteUserHandler.Interactions, 2)
+				assertEqual(t, len(groupUsersData), 1)
+				return nil
+			},
+
+			Steps: []resource.TestStep{
+				step1,
+				step2,
+			},
+		},
+	)
+}
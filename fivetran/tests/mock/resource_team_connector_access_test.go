@@ -0,0 +1,112 @@
+package mock
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/fivetran/go-fivetran/tests/mock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestResourceTeamConnectorAccessMock(t *testing.T) {
+	var postHandler *mock.Handler
+	var patchHandler *mock.Handler
+	var deleteHandler *mock.Handler
+	var membershipData map[string]interface{}
+
+	step1 := resource.TestStep{
+		Config: `
+			resource "fivetran_team_connector_access" "test_access" {
+				provider = fivetran-provider
+
+				team_id = "test_team"
+				connector_id = "test_connector"
+				role = "Connector Reviewer"
+			}`,
+		Check: resource.ComposeAggregateTestCheckFunc(
+			func(s *terraform.State) error {
+				assertEqual(t, postHandler.Interactions, 1)
+				assertEqual(t, patchHandler.Interactions, 0)
+				return nil
+			},
+			resource.TestCheckResourceAttr("fivetran_team_connector_access.test_access", "id", "test_team:test_connector"),
+			resource.TestCheckResourceAttr("fivetran_team_connector_access.test_access", "role", "Connector Reviewer"),
+			resource.TestCheckResourceAttr("fivetran_team_connector_access.test_access", "created_at", "2020-05-25T15:26:47.306509Z"),
+		),
+	}
+
+	step2 := resource.TestStep{
+		Config: `
+			resource "fivetran_team_connector_access" "test_access" {
+				provider = fivetran-provider
+
+				team_id = "test_team"
+				connector_id = "test_connector"
+				role = "Connector Administrator"
+			}`,
+		Check: resource.ComposeAggregateTestCheckFunc(
+			func(s *terraform.State) error {
+				assertEqual(t, postHandler.Interactions, 1)
+				assertEqual(t, patchHandler.Interactions, 1)
+				return nil
+			},
+			resource.TestCheckResourceAttr("fivetran_team_connector_access.test_access", "role", "Connector Administrator"),
+		),
+	}
+
+	resource.Test(
+		t,
+		resource.TestCase{
+			PreCheck: func() {
+				mockClient.Reset()
+
+				postHandler = mockClient.When(http.MethodPost, "/v1/teams/test_team/connectors").ThenCall(
+					func(req *http.Request) (*http.Response, error) {
+						body := requestBodyToJson(t, req)
+						assertKeyExistsAndHasValue(t, body, "id", "test_connector")
+						membershipData = createMapFromJsonString(t, `
+						{
+							"id": "test_connector",
+							"role": "Connector Reviewer",
+							"created_at": "2020-05-25T15:26:47.306509Z"
+						}
+						`)
+						membershipData["role"] = body["role"]
+						return fivetranSuccessResponse(t, req, http.StatusCreated, "Connector membership has been created", membershipData), nil
+					},
+				)
+
+				mockClient.When(http.MethodGet, "/v1/teams/test_team/connectors/test_connector").ThenCall(
+					func(req *http.Request) (*http.Response, error) {
+						return fivetranSuccessResponse(t, req, http.StatusOK, "", membershipData), nil
+					},
+				)
+
+				patchHandler = mockClient.When(http.MethodPatch, "/v1/teams/test_team/connectors/test_connector").ThenCall(
+					func(req *http.Request) (*http.Response, error) {
+						body := requestBodyToJson(t, req)
+						membershipData["role"] = body["role"]
+						return fivetranSuccessResponse(t, req, http.StatusOK, "Connector membership has been updated", nil), nil
+					},
+				)
+
+				deleteHandler = mockClient.When(http.MethodDelete, "/v1/teams/test_team/connectors/test_connector").ThenCall(
+					func(req *http.Request) (*http.Response, error) {
+						return fivetranSuccessResponse(t, req, http.StatusOK, "Connector membership has been deleted", nil), nil
+					},
+				)
+			},
+			ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+			CheckDestroy: func(s *terraform.State) error {
+				assertEqual(t, deleteHandler.Interactions, 1)
+				return nil
+			},
+
+			Steps: []resource.TestStep{
+				step1,
+				step2,
+			},
+		},
+	)
+}
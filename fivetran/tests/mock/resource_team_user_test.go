@@ -0,0 +1,110 @@
+package mock
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/fivetran/go-fivetran/tests/mock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestResourceTeamUserMock(t *testing.T) {
+	var postHandler *mock.Handler
+	var patchHandler *mock.Handler
+	var deleteHandler *mock.Handler
+	var membershipData map[string]interface{}
+
+	step1 := resource.TestStep{
+		Config: `
+			resource "fivetran_team_user" "test_access" {
+				provider = fivetran-provider
+
+				team_id = "test_team"
+				user_id = "test_user"
+				role = "Team Member"
+			}`,
+		Check: resource.ComposeAggregateTestCheckFunc(
+			func(s *terraform.State) error {
+				assertEqual(t, postHandler.Interactions, 1)
+				assertEqual(t, patchHandler.Interactions, 0)
+				return nil
+			},
+			resource.TestCheckResourceAttr("fivetran_team_user.test_access", "id", "test_team:test_user"),
+			resource.TestCheckResourceAttr("fivetran_team_user.test_access", "role", "Team Member"),
+		),
+	}
+
+	step2 := resource.TestStep{
+		Config: `
+			resource "fivetran_team_user" "test_access" {
+				provider = fivetran-provider
+
+				team_id = "test_team"
+				user_id = "test_user"
+				role = "Team Manager"
+			}`,
+		Check: resource.ComposeAggregateTestCheckFunc(
+			func(s *terraform.State) error {
+				assertEqual(t, postHandler.Interactions, 1)
+				assertEqual(t, patchHandler.Interactions, 1)
+				return nil
+			},
+			resource.TestCheckResourceAttr("fivetran_team_user.test_access", "role", "Team Manager"),
+		),
+	}
+
+	resource.Test(
+		t,
+		resource.TestCase{
+			PreCheck: func() {
+				mockClient.Reset()
+
+				postHandler = mockClient.When(http.MethodPost, "/v1/teams/test_team/users").ThenCall(
+					func(req *http.Request) (*http.Response, error) {
+						body := requestBodyToJson(t, req)
+						assertKeyExistsAndHasValue(t, body, "user_id", "test_user")
+						membershipData = createMapFromJsonString(t, `
+						{
+							"user_id": "test_user",
+							"role": "Team Member"
+						}
+						`)
+						membershipData["role"] = body["role"]
+						return fivetranSuccessResponse(t, req, http.StatusCreated, "User membership has been created", membershipData), nil
+					},
+				)
+
+				mockClient.When(http.MethodGet, "/v1/teams/test_team/users/test_user").ThenCall(
+					func(req *http.Request) (*http.Response, error) {
+						return fivetranSuccessResponse(t, req, http.StatusOK, "", membershipData), nil
+					},
+				)
+
+				patchHandler = mockClient.When(http.MethodPatch, "/v1/teams/test_team/users/test_user").ThenCall(
+					func(req *http.Request) (*http.Response, error) {
+						body := requestBodyToJson(t, req)
+						membershipData["role"] = body["role"]
+						return fivetranSuccessResponse(t, req, http.StatusOK, "User membership has been updated", nil), nil
+					},
+				)
+
+				deleteHandler = mockClient.When(http.MethodDelete, "/v1/teams/test_team/users/test_user").ThenCall(
+					func(req *http.Request) (*http.Response, error) {
+						return fivetranSuccessResponse(t, req, http.StatusOK, "User membership has been deleted", nil), nil
+					},
+				)
+			},
+			ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+			CheckDestroy: func(s *terraform.State) error {
+				assertEqual(t, deleteHandler.Interactions, 1)
+				return nil
+			},
+
+			Steps: []resource.TestStep{
+				step1,
+				step2,
+			},
+		},
+	)
+}
@@ -19,6 +19,7 @@ const (
 	ENABLED                = "enabled"
 	HASHED                 = "hashed"
 	SYNC_MODE              = "sync_mode"
+	RESYNC_ON_CHANGE       = "resync_on_change"
 
 	HANDLED       = "handled"
 	EXCLUDED      = "excluded"
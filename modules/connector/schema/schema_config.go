@@ -25,6 +25,22 @@ func (c SchemaConfig) PrepareRequest(svc *connectors.ConnectorSchemaConfigUpdate
 	return svc
 }
 
+// GetTablesToResync returns names of tables (grouped by schema name) that were patched and should be re-synced
+func (c SchemaConfig) GetTablesToResync() map[string][]string {
+	result := make(map[string][]string)
+	for sName, s := range c.schemas {
+		if !s.updated || !s.enabled {
+			continue
+		}
+		for tName, t := range s.tables {
+			if t.needsResync() {
+				result[sName] = append(result[sName], tName)
+			}
+		}
+	}
+	return result
+}
+
 func (c *SchemaConfig) Override(local *SchemaConfig, sch string) error {
 	if local != nil {
 		for sName, s := range c.schemas {
@@ -12,6 +12,8 @@ type _table struct {
 	_element
 	syncMode *string
 	columns  map[string]*_column
+
+	resyncOnChange bool // indicates that table data should be re-synced if table config was patched
 }
 
 func (t *_table) setSyncMode(value *string) {
@@ -39,8 +41,13 @@ func (t _table) prepareRequest() *connectors.ConnectorSchemaConfigTable {
 	}
 	return result
 }
+func (t *_table) needsResync() bool {
+	return t.updated && t.enabled && t.resyncOnChange
+}
+
 func (t *_table) override(local *_table, sch string) error {
 	if local != nil {
+		t.resyncOnChange = local.resyncOnChange
 		if local.enabled != t.enabled {
 			if t.isPatchAllowed() {
 				t.setEnabled(local.enabled)
@@ -101,6 +108,9 @@ func (t *_table) readFromResourceData(source map[string]interface{}, sch string)
 	if sm, ok := source[SYNC_MODE].(string); ok && sm != "" {
 		t.syncMode = &sm
 	}
+	if resync, ok := source[RESYNC_ON_CHANGE]; ok {
+		t.resyncOnChange = getBoolValue(resync)
+	}
 	columns := getColumns(source)
 	if len(columns) > 0 {
 		t.readColumns(columns, sch)
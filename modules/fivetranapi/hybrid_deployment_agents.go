@@ -0,0 +1,81 @@
+package fivetranapi
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/fivetran/go-fivetran"
+	"github.com/fivetran/go-fivetran/common"
+)
+
+// HybridDeploymentAgentDetails contains hybrid deployment agent fields returned by the details API
+type HybridDeploymentAgentDetails struct {
+	Id           string `json:"id"`
+	DisplayName  string `json:"display_name"`
+	GroupId      string `json:"group_id"`
+	RegisteredAt string `json:"registered_at"`
+}
+
+// HybridDeploymentAgentFiles contains the files required to run the agent, they are returned only on creation and re-authentication
+type HybridDeploymentAgentFiles struct {
+	ConfigJson        string `json:"config_json"`
+	AuthJson          string `json:"auth_json"`
+	DockerComposeYaml string `json:"docker_compose_yaml"`
+}
+
+type HybridDeploymentAgentResponse struct {
+	common.CommonResponse
+	Data HybridDeploymentAgentDetails `json:"data"`
+}
+
+type HybridDeploymentAgentCreateResponse struct {
+	common.CommonResponse
+	Data struct {
+		HybridDeploymentAgentDetails
+		Files HybridDeploymentAgentFiles `json:"files"`
+		Token string                     `json:"token"`
+	} `json:"data"`
+}
+
+type hybridDeploymentAgentCreateRequest struct {
+	GroupId     string `json:"group_id"`
+	DisplayName string `json:"display_name"`
+	EnvType     string `json:"env_type"`
+	AuthType    string `json:"auth_type"`
+	AcceptTerms bool   `json:"accept_terms"`
+}
+
+// Ref. https://fivetran.com/docs/rest-api/hybrid-deployment-agent-management#createahybriddeploymentagent
+func CreateHybridDeploymentAgent(ctx context.Context, client *fivetran.Client, groupId, displayName, envType, authType string, acceptTerms bool) (HybridDeploymentAgentCreateResponse, error) {
+	var response HybridDeploymentAgentCreateResponse
+	request := hybridDeploymentAgentCreateRequest{
+		GroupId:     groupId,
+		DisplayName: displayName,
+		EnvType:     envType,
+		AuthType:    authType,
+		AcceptTerms: acceptTerms,
+	}
+	err := client.NewHttpService().Do(ctx, "POST", "/hybrid-deployment-agents", request, nil, 201, &response)
+	return response, err
+}
+
+// Ref. https://fivetran.com/docs/rest-api/hybrid-deployment-agent-management#retrievehybriddeploymentagentdetails
+func HybridDeploymentAgentDetailsById(ctx context.Context, client *fivetran.Client, id string) (HybridDeploymentAgentResponse, error) {
+	var response HybridDeploymentAgentResponse
+	err := client.NewHttpService().Do(ctx, "GET", fmt.Sprintf("/hybrid-deployment-agents/%v", id), nil, nil, 200, &response)
+	return response, err
+}
+
+// Ref. https://fivetran.com/docs/rest-api/hybrid-deployment-agent-management#regeneratekeysforhybriddeploymentagent
+func ReAuthHybridDeploymentAgent(ctx context.Context, client *fivetran.Client, id string) (HybridDeploymentAgentCreateResponse, error) {
+	var response HybridDeploymentAgentCreateResponse
+	err := client.NewHttpService().Do(ctx, "PATCH", fmt.Sprintf("/hybrid-deployment-agents/%v/re-auth", id), nil, nil, 200, &response)
+	return response, err
+}
+
+// Ref. https://fivetran.com/docs/rest-api/hybrid-deployment-agent-management#deleteahybriddeploymentagent
+func DeleteHybridDeploymentAgent(ctx context.Context, client *fivetran.Client, id string) (common.CommonResponse, error) {
+	var response common.CommonResponse
+	err := client.NewHttpService().Do(ctx, "DELETE", fmt.Sprintf("/hybrid-deployment-agents/%v", id), nil, nil, 200, &response)
+	return response, err
+}
@@ -0,0 +1,86 @@
+package fivetranapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/fivetran/go-fivetran"
+	"github.com/fivetran/go-fivetran/common"
+	"github.com/fivetran/go-fivetran/connectors"
+	"github.com/fivetran/go-fivetran/destinations"
+)
+
+// NetworkingDetails contains connection method fields of connectors and destinations
+type NetworkingDetails struct {
+	NetworkingMethod        string `json:"networking_method"`
+	PrivateLinkId           string `json:"private_link_id"`
+	ProxyAgentId            string `json:"proxy_agent_id"`
+	HybridDeploymentAgentId string `json:"hybrid_deployment_agent_id"`
+}
+
+type NetworkingResponse struct {
+	common.CommonResponse
+	Data NetworkingDetails `json:"data"`
+}
+
+// NetworkingModifyRequest updates connection method fields, setup tests are performed according to the flags
+type NetworkingModifyRequest struct {
+	NetworkingMethod        *string `json:"networking_method,omitempty"`
+	PrivateLinkId           *string `json:"private_link_id,omitempty"`
+	ProxyAgentId            *string `json:"proxy_agent_id,omitempty"`
+	RunSetupTests           *bool   `json:"run_setup_tests,omitempty"`
+	TrustCertificates       *bool   `json:"trust_certificates,omitempty"`
+	TrustFingerprints       *bool   `json:"trust_fingerprints,omitempty"`
+	HybridDeploymentAgentId *string `json:"hybrid_deployment_agent_id,omitempty"`
+}
+
+// ConnectorDetails reads the connector details together with the connection method fields that go-fivetran doesn't decode,
+// both are decoded from the same response
+// Ref. https://fivetran.com/docs/rest-api/connectors#retrieveconnectordetails
+func ConnectorDetails(ctx context.Context, client *fivetran.Client, connectorId string) (connectors.DetailsWithCustomConfigNoTestsResponse, NetworkingResponse, error) {
+	var response connectors.DetailsWithCustomConfigNoTestsResponse
+	var networkingResponse NetworkingResponse
+	err := getDecoded(ctx, client, fmt.Sprintf("/connectors/%v", connectorId), &response, &networkingResponse)
+	return response, networkingResponse, err
+}
+
+// Ref. https://fivetran.com/docs/rest-api/connectors#modifyaconnector
+func ModifyConnectorNetworking(ctx context.Context, client *fivetran.Client, connectorId string, request NetworkingModifyRequest) (connectors.DetailsWithCustomConfigResponse, error) {
+	var response connectors.DetailsWithCustomConfigResponse
+	err := client.NewHttpService().Do(ctx, "PATCH", fmt.Sprintf("/connectors/%v", connectorId), request, nil, 200, &response)
+	return response, err
+}
+
+// DestinationDetails reads the destination details together with the connection method fields that go-fivetran doesn't decode,
+// both are decoded from the same response
+// Ref. https://fivetran.com/docs/rest-api/destinations#retrievedestinationdetails
+func DestinationDetails(ctx context.Context, client *fivetran.Client, destinationId string) (destinations.DestinationDetailsCustomResponse, NetworkingResponse, error) {
+	var response destinations.DestinationDetailsCustomResponse
+	var networkingResponse NetworkingResponse
+	err := getDecoded(ctx, client, fmt.Sprintf("/destinations/%v", destinationId), &response, &networkingResponse)
+	return response, networkingResponse, err
+}
+
+// Ref. https://fivetran.com/docs/rest-api/destinations#modifyadestination
+func ModifyDestinationNetworking(ctx context.Context, client *fivetran.Client, destinationId string, request NetworkingModifyRequest) (destinations.DestinationDetailsWithSetupTestsCustomResponse, error) {
+	var response destinations.DestinationDetailsWithSetupTestsCustomResponse
+	err := client.NewHttpService().Do(ctx, "PATCH", fmt.Sprintf("/destinations/%v", destinationId), request, nil, 200, &response)
+	return response, err
+}
+
+// getDecoded sends GET request and decodes the response body into each of the responses,
+// the body of the error response is decoded as well to keep its code and message
+func getDecoded(ctx context.Context, client *fivetran.Client, url string, responses ...any) error {
+	var body json.RawMessage
+	err := client.NewHttpService().Do(ctx, "GET", url, nil, nil, 200, &body)
+	if len(body) == 0 {
+		return err
+	}
+	for _, response := range responses {
+		if decodeErr := json.Unmarshal(body, response); decodeErr != nil && err == nil {
+			err = decodeErr
+		}
+	}
+	return err
+}
@@ -0,0 +1,58 @@
+// Package fivetranapi implements the Fivetran REST API calls that are not supported
+// by the go-fivetran client version used by the provider.
+package fivetranapi
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/fivetran/go-fivetran"
+	privatelinks "github.com/fivetran/go-fivetran/private_links"
+)
+
+type PrivateLinksListResponse struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+	Data    struct {
+		Items      []privatelinks.PrivateLinksResponseBase `json:"items"`
+		NextCursor string                                  `json:"next_cursor"`
+	} `json:"data"`
+}
+
+type privateLinkCreateRequest struct {
+	Name    string      `json:"name"`
+	Region  string      `json:"region"`
+	Service string      `json:"service"`
+	Config  interface{} `json:"config,omitempty"`
+}
+
+// CreatePrivateLink creates a private link in the region, go-fivetran create service supports only group-based private links
+// Ref. https://fivetran.com/docs/rest-api/private-links-management#createaprivatelink
+func CreatePrivateLink(ctx context.Context, client *fivetran.Client, name, region, service string, config *privatelinks.PrivateLinksConfig) (privatelinks.PrivateLinksResponse, error) {
+	var response privatelinks.PrivateLinksResponse
+	request := privateLinkCreateRequest{
+		Name:    name,
+		Region:  region,
+		Service: service,
+	}
+	if config != nil {
+		request.Config = config.Request()
+	}
+	err := client.NewHttpService().Do(ctx, "POST", "/private-links", request, nil, 201, &response)
+	return response, err
+}
+
+// ListPrivateLinks lists all the private links of the account, go-fivetran supports only listing the private links of a group
+// Ref. https://fivetran.com/docs/rest-api/private-links-management#listallprivatelinkswithinaccount
+func ListPrivateLinks(ctx context.Context, client *fivetran.Client, limit int, cursor string) (PrivateLinksListResponse, error) {
+	var response PrivateLinksListResponse
+	queries := map[string]string{}
+	if limit > 0 {
+		queries["limit"] = fmt.Sprint(limit)
+	}
+	if cursor != "" {
+		queries["cursor"] = cursor
+	}
+	err := client.NewHttpService().Do(ctx, "GET", "/private-links", nil, queries, 200, &response)
+	return response, err
+}
@@ -0,0 +1,87 @@
+package fivetranapi
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/fivetran/go-fivetran"
+	"github.com/fivetran/go-fivetran/common"
+)
+
+// ProxyAgentDetails contains proxy agent fields returned by the details and list API
+type ProxyAgentDetails struct {
+	Id           string `json:"id"`
+	AccountId    string `json:"account_id"`
+	RegisteredAt string `json:"registred_at"`
+	Region       string `json:"region"`
+	Token        string `json:"token"`
+	Salt         string `json:"salt"`
+	CreatedBy    string `json:"created_by"`
+	DisplayName  string `json:"display_name"`
+}
+
+type ProxyAgentResponse struct {
+	common.CommonResponse
+	Data ProxyAgentDetails `json:"data"`
+}
+
+type ProxyAgentCreateResponse struct {
+	common.CommonResponse
+	Data struct {
+		AgentId        string `json:"agent_id"`
+		AuthToken      string `json:"auth_token"`
+		ProxyServerUri string `json:"proxy_server_uri"`
+	} `json:"data"`
+}
+
+type ProxyAgentsListResponse struct {
+	common.CommonResponse
+	Data struct {
+		Items      []ProxyAgentDetails `json:"items"`
+		NextCursor string              `json:"next_cursor"`
+	} `json:"data"`
+}
+
+type proxyAgentCreateRequest struct {
+	DisplayName string `json:"display_name"`
+	GroupRegion string `json:"group_region"`
+}
+
+// Ref. https://fivetran.com/docs/rest-api/proxy-management#createaproxyagent
+func CreateProxyAgent(ctx context.Context, client *fivetran.Client, displayName, groupRegion string) (ProxyAgentCreateResponse, error) {
+	var response ProxyAgentCreateResponse
+	request := proxyAgentCreateRequest{
+		DisplayName: displayName,
+		GroupRegion: groupRegion,
+	}
+	err := client.NewHttpService().Do(ctx, "POST", "/proxy", request, nil, 201, &response)
+	return response, err
+}
+
+// Ref. https://fivetran.com/docs/rest-api/proxy-management#retrieveproxyagentdetails
+func ProxyAgentDetailsById(ctx context.Context, client *fivetran.Client, id string) (ProxyAgentResponse, error) {
+	var response ProxyAgentResponse
+	err := client.NewHttpService().Do(ctx, "GET", fmt.Sprintf("/proxy/%v", id), nil, nil, 200, &response)
+	return response, err
+}
+
+// Ref. https://fivetran.com/docs/rest-api/proxy-management#deleteaproxyagent
+func DeleteProxyAgent(ctx context.Context, client *fivetran.Client, id string) (common.CommonResponse, error) {
+	var response common.CommonResponse
+	err := client.NewHttpService().Do(ctx, "DELETE", fmt.Sprintf("/proxy/%v", id), nil, nil, 200, &response)
+	return response, err
+}
+
+// Ref. https://fivetran.com/docs/rest-api/proxy-management#listallproxyagents
+func ListProxyAgents(ctx context.Context, client *fivetran.Client, limit int, cursor string) (ProxyAgentsListResponse, error) {
+	var response ProxyAgentsListResponse
+	queries := map[string]string{}
+	if limit > 0 {
+		queries["limit"] = fmt.Sprint(limit)
+	}
+	if cursor != "" {
+		queries["cursor"] = cursor
+	}
+	err := client.NewHttpService().Do(ctx, "GET", "/proxy", nil, queries, 200, &response)
+	return response, err
+}
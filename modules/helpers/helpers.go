@@ -320,3 +320,15 @@ func StringInt32Hash(s string) int {
 	h.Write(hashKey)
 	return int(h.Sum32())
 }
+
+// IsNotFound accepts the error response code and the error returned by the API call and reports
+// whether the requested resource doesn't exist (API responds with `404` status or `NotFound` code)
+func IsNotFound(code string, err error) bool {
+	if err == nil {
+		return false
+	}
+	if code == "404" || code == "NotFound" || strings.HasPrefix(code, "NotFound_") {
+		return true
+	}
+	return strings.HasPrefix(err.Error(), "status code: 404;")
+}
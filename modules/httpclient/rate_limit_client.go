@@ -0,0 +1,167 @@
+package httpclient
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	httputils "github.com/fivetran/go-fivetran/http_utils"
+	"github.com/fivetran/terraform-provider-fivetran/modules/helpers"
+)
+
+// RateLimiter is a token bucket limiter combined with the limit of concurrently executed requests.
+// Zero values of requestsPerSecond and maxConcurrent mean no limit.
+type RateLimiter struct {
+	requestsPerSecond float64
+	maxConcurrent     int
+
+	mutex    sync.Mutex
+	tokens   float64
+	lastFill time.Time
+
+	semaphore chan struct{}
+}
+
+var (
+	sharedLimiterMutex sync.Mutex
+	sharedLimiter      *RateLimiter
+)
+
+// SharedRateLimiter returns the limiter shared by all the provider instances within the plugin process.
+// Framework and SDK providers are configured independently with the same settings, so the limiter
+// is created by the first of them and reused by the second one.
+func SharedRateLimiter(requestsPerSecond float64, maxConcurrent int) *RateLimiter {
+	sharedLimiterMutex.Lock()
+	defer sharedLimiterMutex.Unlock()
+
+	if sharedLimiter == nil ||
+		sharedLimiter.requestsPerSecond != requestsPerSecond ||
+		sharedLimiter.maxConcurrent != maxConcurrent {
+		sharedLimiter = NewRateLimiter(requestsPerSecond, maxConcurrent)
+	}
+	return sharedLimiter
+}
+
+func NewRateLimiter(requestsPerSecond float64, maxConcurrent int) *RateLimiter {
+	result := &RateLimiter{
+		requestsPerSecond: requestsPerSecond,
+		maxConcurrent:     maxConcurrent,
+		tokens:            burst(requestsPerSecond),
+		lastFill:          time.Now(),
+	}
+	if maxConcurrent > 0 {
+		result.semaphore = make(chan struct{}, maxConcurrent)
+	}
+	return result
+}
+
+// burst returns the bucket capacity: one second worth of requests, but at least one request
+func burst(requestsPerSecond float64) float64 {
+	if requestsPerSecond < 1 {
+		return 1
+	}
+	return requestsPerSecond
+}
+
+// Acquire blocks until the request is allowed to be sent. The returned function should be called
+// when the request is finished, it's safe to call it more than once.
+func (l *RateLimiter) Acquire(ctx context.Context) (func(), error) {
+	if l.semaphore != nil {
+		select {
+		case l.semaphore <- struct{}{}:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	var once sync.Once
+	release := func() {
+		once.Do(func() {
+			if l.semaphore != nil {
+				<-l.semaphore
+			}
+		})
+	}
+
+	if err := l.wait(ctx); err != nil {
+		release()
+		return nil, err
+	}
+	return release, nil
+}
+
+func (l *RateLimiter) wait(ctx context.Context) error {
+	if l.requestsPerSecond <= 0 {
+		return nil
+	}
+	for {
+		delay := l.reserve()
+		if delay == 0 {
+			return nil
+		}
+		if err := helpers.ContextDelay(ctx, delay); err != nil {
+			return err
+		}
+	}
+}
+
+// reserve takes a token from the bucket if possible, otherwise returns time to wait for the next token
+func (l *RateLimiter) reserve() time.Duration {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	now := time.Now()
+	l.tokens += now.Sub(l.lastFill).Seconds() * l.requestsPerSecond
+	if capacity := burst(l.requestsPerSecond); l.tokens > capacity {
+		l.tokens = capacity
+	}
+	l.lastFill = now
+
+	if l.tokens >= 1 {
+		l.tokens--
+		return 0
+	}
+	return time.Duration((1 - l.tokens) / l.requestsPerSecond * float64(time.Second))
+}
+
+// RateLimitClient wraps HttpClient and sends requests only when the limiter allows it.
+// The request holds the concurrency slot until the response body is closed.
+type RateLimitClient struct {
+	client  httputils.HttpClient
+	limiter *RateLimiter
+}
+
+func NewRateLimitClient(client httputils.HttpClient, limiter *RateLimiter) *RateLimitClient {
+	return &RateLimitClient{
+		client:  client,
+		limiter: limiter,
+	}
+}
+
+func (c *RateLimitClient) Do(req *http.Request) (*http.Response, error) {
+	release, err := c.limiter.Acquire(req.Context())
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil || resp == nil || resp.Body == nil {
+		release()
+		return resp, err
+	}
+	resp.Body = &releaseOnClose{ReadCloser: resp.Body, release: release}
+	return resp, nil
+}
+
+// releaseOnClose releases the concurrency slot of the request when the response body is closed
+type releaseOnClose struct {
+	io.ReadCloser
+	release func()
+}
+
+func (b *releaseOnClose) Close() error {
+	defer b.release()
+	return b.ReadCloser.Close()
+}
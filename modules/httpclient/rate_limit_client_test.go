@@ -0,0 +1,58 @@
+package httpclient_test
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/fivetran/terraform-provider-fivetran/modules/httpclient"
+)
+
+type httpClientFunc func(req *http.Request) (*http.Response, error)
+
+func (f httpClientFunc) Do(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestRateLimitClientReleasesSlotOnBodyClose(t *testing.T) {
+	client := httpclient.NewRateLimitClient(
+		httpClientFunc(func(req *http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader("{}"))}, nil
+		}),
+		httpclient.NewRateLimiter(0, 1))
+
+	req, _ := http.NewRequest(http.MethodGet, "https://api.fivetran.com/v1/groups", nil)
+
+	first, err := client.Do(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	done := make(chan *http.Response)
+	go func() {
+		second, _ := client.Do(req)
+		done <- second
+	}()
+
+	select {
+	case <-done:
+		t.Fatalf("second request was sent before the body of the first response was closed")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	if _, err := io.ReadAll(first.Body); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	first.Body.Close()
+	// repeated close shouldn't release the slot of another request
+	first.Body.Close()
+
+	select {
+	case second := <-done:
+		second.Body.Close()
+	case <-time.After(time.Second):
+		t.Fatalf("second request wasn't sent after the body of the first response was closed")
+	}
+}
@@ -0,0 +1,155 @@
+package httpclient
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	httputils "github.com/fivetran/go-fivetran/http_utils"
+	"github.com/fivetran/terraform-provider-fivetran/modules/helpers"
+)
+
+const (
+	DefaultMaxRetries   = 3
+	DefaultRetryMinWait = 1 * time.Second
+	DefaultRetryMaxWait = 30 * time.Second
+)
+
+// RetryClient wraps HttpClient and retries requests failed with 429 (for any method)
+// and 5xx or connection errors (for idempotent methods only) with exponential backoff.
+// Zero maxRetries disables all the retries.
+type RetryClient struct {
+	client     httputils.HttpClient
+	maxRetries int
+	minWait    time.Duration
+	maxWait    time.Duration
+}
+
+func NewRetryClient(client httputils.HttpClient, maxRetries int, minWait, maxWait time.Duration) *RetryClient {
+	if maxWait < minWait {
+		maxWait = minWait
+	}
+	return &RetryClient{
+		client:     client,
+		maxRetries: maxRetries,
+		minWait:    minWait,
+		maxWait:    maxWait,
+	}
+}
+
+func (c *RetryClient) Do(req *http.Request) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		attemptReq, err := rewindRequest(req, attempt)
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err := c.client.Do(attemptReq)
+
+		if attempt >= c.maxRetries || !shouldRetry(req, resp, err) {
+			return resp, err
+		}
+
+		wait := c.backoff(attempt, resp)
+
+		if resp != nil {
+			resp.Body.Close()
+		}
+
+		if err := helpers.ContextDelay(req.Context(), wait); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// backoff returns the delay before the next attempt: Retry-After value if the server provided it
+// or exponentially growing delay between minWait and maxWait otherwise
+func (c *RetryClient) backoff(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if retryAfter, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+			return retryAfter
+		}
+	}
+
+	wait := c.minWait
+	for i := 0; i < attempt && wait < c.maxWait; i++ {
+		wait *= 2
+	}
+	if wait > c.maxWait {
+		wait = c.maxWait
+	}
+	return wait
+}
+
+func shouldRetry(req *http.Request, resp *http.Response, err error) bool {
+	if err != nil {
+		// request cancelled by the caller shouldn't be retried
+		if req.Context().Err() != nil {
+			return false
+		}
+		return isIdempotent(req.Method)
+	}
+	if resp.StatusCode == http.StatusTooManyRequests {
+		// request was rejected by the server without processing, so it is safe to repeat it
+		return true
+	}
+	return resp.StatusCode >= http.StatusInternalServerError && isIdempotent(req.Method)
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		wait := time.Until(date)
+		if wait < 0 {
+			wait = 0
+		}
+		return wait, true
+	}
+	return 0, false
+}
+
+// rewindRequest returns the request to be sent on the given attempt with the body reset to its beginning
+func rewindRequest(req *http.Request, attempt int) (*http.Request, error) {
+	if attempt == 0 || req.Body == nil || req.Body == http.NoBody {
+		return req, nil
+	}
+	if req.GetBody == nil {
+		return nil, fmt.Errorf("unable to retry %v %v: request body can't be re-read", req.Method, req.URL)
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, err
+	}
+	result := req.Clone(req.Context())
+	result.Body = body
+	return result, nil
+}
+
+// ParseWait parses retry wait duration defined in provider configuration (e.g. "500ms", "5s", "1m")
+func ParseWait(value string, defaultValue time.Duration) (time.Duration, error) {
+	if value == "" {
+		return defaultValue, nil
+	}
+	result, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, err
+	}
+	if result < 0 {
+		return 0, fmt.Errorf("wait duration can't be negative: %v", value)
+	}
+	return result, nil
+}
@@ -0,0 +1,135 @@
+package httpclient_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	gofivetran "github.com/fivetran/go-fivetran"
+	"github.com/fivetran/terraform-provider-fivetran/fivetran"
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/framework"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+const groupResponse = `{"code": "Success", "data": {"id": "group_id", "name": "group_name", "created_at": "2018-12-20T11:59:35.089589Z"}}`
+
+// newServer returns the server that responds with the given statuses first and with the group details after that
+func newServer(t *testing.T, statuses ...int) (*httptest.Server, *int32) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempt := int(atomic.AddInt32(&requests, 1))
+		if attempt <= len(statuses) {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(statuses[attempt-1])
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(groupResponse))
+	}))
+	t.Cleanup(server.Close)
+	return server, &requests
+}
+
+func configureSdkProvider(t *testing.T, apiUrl string, maxRetries int) *gofivetran.Client {
+	p := fivetran.Provider()
+	config := map[string]interface{}{
+		"api_key":        "key",
+		"api_secret":     "secret",
+		"api_url":        apiUrl,
+		"retry_min_wait": "1ms",
+		"retry_max_wait": "1ms",
+	}
+	if maxRetries >= 0 {
+		config["max_retries"] = maxRetries
+	}
+	diags := p.Configure(context.Background(), terraform.NewResourceConfigRaw(config))
+	if diags.HasError() {
+		t.Fatalf("unable to configure SDK provider: %v", diags)
+	}
+	return p.Meta().(*gofivetran.Client)
+}
+
+func configureFrameworkProvider(t *testing.T, apiUrl string, maxRetries int) *gofivetran.Client {
+	ctx := context.Background()
+	p := framework.FivetranProvider()
+
+	schemaResp := provider.SchemaResponse{}
+	p.Schema(ctx, provider.SchemaRequest{}, &schemaResp)
+	configType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	values := map[string]tftypes.Value{}
+	for name, attrType := range configType.AttributeTypes {
+		values[name] = tftypes.NewValue(attrType, nil)
+	}
+	values["api_key"] = tftypes.NewValue(tftypes.String, "key")
+	values["api_secret"] = tftypes.NewValue(tftypes.String, "secret")
+	values["api_url"] = tftypes.NewValue(tftypes.String, apiUrl)
+	if maxRetries >= 0 {
+		values["max_retries"] = tftypes.NewValue(tftypes.Number, maxRetries)
+	}
+	values["retry_min_wait"] = tftypes.NewValue(tftypes.String, "1ms")
+	values["retry_max_wait"] = tftypes.NewValue(tftypes.String, "1ms")
+
+	resp := provider.ConfigureResponse{}
+	p.Configure(ctx, provider.ConfigureRequest{
+		Config: tfsdk.Config{Raw: tftypes.NewValue(configType, values), Schema: schemaResp.Schema},
+	}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unable to configure framework provider: %v", resp.Diagnostics)
+	}
+	return resp.ResourceData.(*gofivetran.Client)
+}
+
+// TestProviderConfigureRetries checks both providers, negative maxRetries means the value is not configured
+func TestProviderConfigureRetries(t *testing.T) {
+	providers := map[string]func(t *testing.T, apiUrl string, maxRetries int) *gofivetran.Client{
+		"sdk":       configureSdkProvider,
+		"framework": configureFrameworkProvider,
+	}
+	cases := []struct {
+		name             string
+		maxRetries       int
+		statuses         []int
+		expectedRequests int32
+		expectError      bool
+	}{
+		{"rate limit retried", 3, []int{429, 429}, 3, false},
+		{"server error retried", 3, []int{500, 503}, 3, false},
+		{"retries exhausted", 1, []int{500, 500}, 2, true},
+		{"rate limit retried by default", -1, []int{429, 429, 429}, 4, false},
+		{"server error retried by default", -1, []int{500, 500, 500, 500}, 4, true},
+		{"rate limit not retried with disabled retries", 0, []int{429}, 1, true},
+		{"server error not retried with disabled retries", 0, []int{500}, 1, true},
+	}
+
+	for providerName, configure := range providers {
+		for _, c := range cases {
+			t.Run(providerName+"/"+c.name, func(t *testing.T) {
+				server, requests := newServer(t, c.statuses...)
+				client := configure(t, server.URL, c.maxRetries)
+
+				response, err := client.NewGroupDetails().GroupID("group_id").Do(context.Background())
+
+				if c.expectError && err == nil {
+					t.Errorf("expected error, got response %v", response)
+				}
+				if !c.expectError {
+					if err != nil {
+						t.Fatalf("unexpected error: %v", err)
+					}
+					if response.Data.ID != "group_id" {
+						t.Errorf("unexpected response: %v", response)
+					}
+				}
+				if *requests != c.expectedRequests {
+					t.Errorf("expected %v requests, got %v", c.expectedRequests, *requests)
+				}
+			})
+		}
+	}
+}
@@ -6,7 +6,9 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"reflect"
 	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/Jeffail/gabs/v2"
@@ -204,7 +206,8 @@ func importFields(
 	for _, service := range services {
 		path := SCHEMAS_PATH + service + propPath
 		serviceSchema := schemaContainer.Path(path).ChildrenMap()
-		serviceFieldsMap := createFields(serviceSchema, service)
+		requiredFields := getRequiredFields(schemaContainer.Path(strings.TrimSuffix(path, ".properties") + ".required"))
+		serviceFieldsMap := createFields(serviceSchema, requiredFields, service)
 
 		for name, field := range serviceFieldsMap {
 			fmt.Println("INFO: processing field " + name + " (service " + service + ")")
@@ -311,6 +314,36 @@ func appendItemType(newField, existingField *common.ConfigField, service string)
 	return false
 }
 
+// appendServiceValue updates the service value in existing per-service map, value is removed if the new field doesn't have it anymore
+func appendServiceValue[T any](existingValues *map[string]T, newValues map[string]T, service string) bool {
+	nv, nok := newValues[service]
+	ev, eok := (*existingValues)[service]
+	if !nok {
+		if eok {
+			delete(*existingValues, service)
+			return true
+		}
+		return false
+	}
+	if eok && reflect.DeepEqual(ev, nv) {
+		return false
+	}
+	if *existingValues == nil {
+		*existingValues = make(map[string]T)
+	}
+	(*existingValues)[service] = nv
+	return true
+}
+
+func appendConstraints(newField, existingField *common.ConfigField, service string) bool {
+	updatedRequired := appendServiceValue(&existingField.Required, newField.Required, service)
+	updatedEnum := appendServiceValue(&existingField.Enum, newField.Enum, service)
+	updatedDefault := appendServiceValue(&existingField.Default, newField.Default, service)
+	updatedMinimum := appendServiceValue(&existingField.Minimum, newField.Minimum, service)
+	updatedMaximum := appendServiceValue(&existingField.Maximum, newField.Maximum, service)
+	return updatedRequired || updatedEnum || updatedDefault || updatedMinimum || updatedMaximum
+}
+
 func ableToMergeFields(a, b common.ConfigField) bool {
 	if a.FieldValueType != b.FieldValueType {
 		// can't merge fields of different types
@@ -340,7 +373,8 @@ func mergeFields(newField, existingField common.ConfigField, service string, cha
 		// there's nothing to merge for primitive types, there won't be any updates in schema
 		updatedDescriptoion := appendFieldDescription(&newField, &existingField, service)
 		updatedItemType := appendItemType(&newField, &existingField, service)
-		return existingField, updatedDescriptoion || updatedItemType
+		updatedConstraints := appendConstraints(&newField, &existingField, service)
+		return existingField, updatedDescriptoion || updatedItemType || updatedConstraints
 	}
 
 	updated := false
@@ -363,10 +397,11 @@ func mergeFields(newField, existingField common.ConfigField, service string, cha
 	}
 	updatedDescription := appendFieldDescription(&newField, &existingField, service)
 	updatedItemType := appendItemType(&newField, &existingField, service)
-	return existingField, updated || updatedDescription || updatedItemType
+	updatedConstraints := appendConstraints(&newField, &existingField, service)
+	return existingField, updated || updatedDescription || updatedItemType || updatedConstraints
 }
 
-func createFields(nodesMap map[string]*gabs.Container, service string) map[string]common.ConfigField {
+func createFields(nodesMap map[string]*gabs.Container, requiredFields map[string]bool, service string) map[string]common.ConfigField {
 	fields := make(map[string]common.ConfigField)
 
 	for key, node := range nodesMap {
@@ -388,9 +423,11 @@ func createFields(nodesMap map[string]*gabs.Container, service string) map[strin
 		case ARRAY_FIELD:
 			fieldInfo = getArrayFieldSchema(node, fieldInfo, service)
 		case OBJECT_FIELD:
-			fieldInfo = getObjectField(node.Path("properties").ChildrenMap(), service, node.Path("enum").Data(), false)
+			fieldInfo = getObjectField(node.Path("properties").ChildrenMap(), getRequiredFields(node.Path("required")), service, node.Path("enum").Data(), false)
 		}
 
+		fillConstraints(&fieldInfo, node, requiredFields[key], service)
+
 		nodeDescription := node.Search("description").Data()
 
 		if nodeDescription != nil {
@@ -416,7 +453,7 @@ func getArrayFieldSchema(node *gabs.Container, field common.ConfigField, service
 		field.FieldValueType = common.StringList
 		field.ItemType[service] = common.String
 	} else if itemType == OBJECT_FIELD {
-		return getObjectField(node.Path("items.properties").ChildrenMap(), service, node.Path("items.enum").Data(), true)
+		return getObjectField(node.Path("items.properties").ChildrenMap(), getRequiredFields(node.Path("items.required")), service, node.Path("items.enum").Data(), true)
 	} else if itemType == INT_FIELD {
 		field.FieldValueType = common.StringList
 		field.ItemType[service] = common.Integer
@@ -425,7 +462,7 @@ func getArrayFieldSchema(node *gabs.Container, field common.ConfigField, service
 	return field
 }
 
-func getObjectField(childrenMap map[string]*gabs.Container, service string, enumElements interface{}, isArray bool) common.ConfigField {
+func getObjectField(childrenMap map[string]*gabs.Container, requiredFields map[string]bool, service string, enumElements interface{}, isArray bool) common.ConfigField {
 	field := common.NewconfigField()
 	if len(childrenMap) > 0 {
 		if isArray {
@@ -439,7 +476,7 @@ func getObjectField(childrenMap map[string]*gabs.Container, service string, enum
 
 		field.ItemFields = make(map[string]common.ConfigField)
 
-		for k, v := range createFields(childrenMap, service) {
+		for k, v := range createFields(childrenMap, requiredFields, service) {
 			if v.Sensitive {
 				needItemKey = true
 			} else {
@@ -477,6 +514,62 @@ func getObjectField(childrenMap map[string]*gabs.Container, service string, enum
 	return field
 }
 
+// fillConstraints sets required flag, enum options, default value and min/max constraints of the field for the service,
+// enum options are set only for string fields and string list items: integer values don't have enums in the metadata
+func fillConstraints(field *common.ConfigField, node *gabs.Container, required bool, service string) {
+	if required {
+		field.Required = map[string]bool{service: true}
+	}
+
+	isString := field.FieldValueType == common.String ||
+		(field.FieldValueType == common.StringList && field.ItemType[service] != common.Integer)
+	if isString {
+		enumElements := node.Path("enum").Data()
+		if enumElements == nil {
+			enumElements = node.Path("items.enum").Data()
+		}
+		if elements, ok := enumElements.([]interface{}); ok && len(elements) > 0 {
+			options := make([]string, 0, len(elements))
+			for _, e := range elements {
+				options = append(options, formatSchemaValue(e))
+			}
+			field.Enum = map[string][]string{service: options}
+		}
+	}
+
+	if defaultValue := node.Path("default").Data(); defaultValue != nil {
+		field.Default = map[string]string{service: formatSchemaValue(defaultValue)}
+	}
+
+	if field.FieldValueType == common.Integer {
+		if minimum, ok := node.Path("minimum").Data().(float64); ok {
+			field.Minimum = map[string]int64{service: int64(minimum)}
+		}
+		if maximum, ok := node.Path("maximum").Data().(float64); ok {
+			field.Maximum = map[string]int64{service: int64(maximum)}
+		}
+	}
+}
+
+func getRequiredFields(node *gabs.Container) map[string]bool {
+	result := make(map[string]bool)
+	if elements, ok := node.Data().([]interface{}); ok {
+		for _, e := range elements {
+			if name, ok := e.(string); ok {
+				result[name] = true
+			}
+		}
+	}
+	return result
+}
+
+func formatSchemaValue(value interface{}) string {
+	if f, ok := value.(float64); ok {
+		return strconv.FormatFloat(f, 'f', -1, 64)
+	}
+	return fmt.Sprintf("%v", value)
+}
+
 func getSchemaJson() *gabs.Container {
 	fmt.Println("Reading OAS file...")
 	oasJson, err := os.ReadFile("open-api-spec.json")
@@ -0,0 +1,390 @@
+package main
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/fivetran/go-fivetran/connectors"
+	"github.com/fivetran/go-fivetran/destinations"
+	"github.com/fivetran/go-fivetran/groups"
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/common"
+	configSchema "github.com/fivetran/terraform-provider-fivetran/modules/connector/schema"
+	"github.com/fivetran/terraform-provider-fivetran/modules/helpers"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/zclconf/go-cty/cty"
+)
+
+var invalidNameChars = regexp.MustCompile(`[^a-z0-9_]+`)
+
+type generator struct {
+	file      *hclwrite.File
+	variables *hclwrite.File
+
+	names map[string]bool
+}
+
+func newGenerator() *generator {
+	return &generator{
+		file:      hclwrite.NewEmptyFile(),
+		variables: hclwrite.NewEmptyFile(),
+		names:     make(map[string]bool),
+	}
+}
+
+func (g *generator) bytes() []byte {
+	if len(g.variables.Body().Blocks()) == 0 {
+		return g.file.Bytes()
+	}
+	return append(append(g.variables.Bytes(), '\n'), g.file.Bytes()...)
+}
+
+// resourceName converts the given value into unique terraform resource name
+func (g *generator) resourceName(resourceType, value string) string {
+	name := strings.Trim(invalidNameChars.ReplaceAllString(strings.ToLower(value), "_"), "_")
+	if name == "" || (name[0] >= '0' && name[0] <= '9') {
+		name = "_" + name
+	}
+	result := name
+	for i := 2; g.names[resourceType+"."+result]; i++ {
+		result = fmt.Sprintf("%v_%v", name, i)
+	}
+	g.names[resourceType+"."+result] = true
+	return result
+}
+
+func traversal(names ...string) hcl.Traversal {
+	result := hcl.Traversal{hcl.TraverseRoot{Name: names[0]}}
+	for _, n := range names[1:] {
+		result = append(result, hcl.TraverseAttr{Name: n})
+	}
+	return result
+}
+
+// appendSeparator separates top level blocks with an empty line
+func appendSeparator(body *hclwrite.Body) {
+	if len(body.Blocks()) > 0 {
+		body.AppendNewline()
+	}
+}
+
+func (g *generator) addResource(resourceType, name string) *hclwrite.Body {
+	appendSeparator(g.file.Body())
+	return g.file.Body().AppendNewBlock("resource", []string{resourceType, name}).Body()
+}
+
+func (g *generator) addImport(resourceType, name, id string) {
+	appendSeparator(g.file.Body())
+	body := g.file.Body().AppendNewBlock("import", nil).Body()
+	body.SetAttributeTraversal("to", traversal(resourceType, name))
+	body.SetAttributeValue("id", cty.StringVal(id))
+}
+
+// addSensitiveVariable declares variable for the sensitive value that can't be read from API
+func (g *generator) addSensitiveVariable(name string, field common.ConfigField) hcl.Traversal {
+	appendSeparator(g.variables.Body())
+	body := g.variables.Body().AppendNewBlock("variable", []string{name}).Body()
+	body.SetAttributeRaw("type", variableType(field))
+	body.SetAttributeValue("sensitive", cty.True)
+	return traversal("var", name)
+}
+
+// variableType returns the type constraint of the variable that holds the value of the field
+func variableType(field common.ConfigField) hclwrite.Tokens {
+	switch field.FieldValueType {
+	case common.Integer:
+		return hclwrite.TokensForIdentifier("number")
+	case common.Boolean:
+		return hclwrite.TokensForIdentifier("bool")
+	case common.StringList:
+		return hclwrite.TokensForFunctionCall("list", hclwrite.TokensForIdentifier("string"))
+	case common.Object, common.ObjectList:
+		// the exact object type isn't needed to set the value, so it's left to Terraform to infer
+		return hclwrite.TokensForIdentifier("any")
+	}
+	return hclwrite.TokensForIdentifier("string")
+}
+
+// hasSensitiveValues checks if the value of the field contains values masked by API
+func hasSensitiveValues(field common.ConfigField) bool {
+	if field.Sensitive {
+		return true
+	}
+	for _, f := range field.ItemFields {
+		if !f.Readonly && hasSensitiveValues(f) {
+			return true
+		}
+	}
+	return false
+}
+
+func (g *generator) addGroup(group groups.GroupItem) string {
+	name := g.resourceName("fivetran_group", group.Name)
+
+	body := g.addResource("fivetran_group", name)
+	body.SetAttributeValue("name", cty.StringVal(group.Name))
+
+	g.addImport("fivetran_group", name, group.ID)
+	return name
+}
+
+func (g *generator) addDestination(groupName string, resp destinations.DestinationDetailsCustomResponse) {
+	body := g.addResource("fivetran_destination", groupName)
+	body.SetAttributeTraversal("group_id", traversal("fivetran_group", groupName, "id"))
+	body.SetAttributeValue("service", cty.StringVal(resp.Data.Service))
+	body.SetAttributeValue("region", cty.StringVal(resp.Data.Region))
+	body.SetAttributeValue("time_zone_offset", cty.StringVal(resp.Data.TimeZoneOffset))
+	body.SetAttributeValue("run_setup_tests", cty.False)
+
+	body.AppendNewline()
+	config := body.AppendNewBlock("config", nil).Body()
+	g.writeConfig(config, common.GetDestinationFieldsForService(resp.Data.Service), resp.Data.Config, "destination_"+groupName)
+
+	g.addImport("fivetran_destination", groupName, resp.Data.ID)
+}
+
+func (g *generator) addConnector(groupName string, resp connectors.DetailsWithCustomConfigNoTestsResponse) string {
+	service := resp.Data.Service
+	name := g.resourceName("fivetran_connector", groupName+"_"+resp.Data.Schema)
+
+	body := g.addResource("fivetran_connector", name)
+	body.SetAttributeTraversal("group_id", traversal("fivetran_group", groupName, "id"))
+	body.SetAttributeValue("service", cty.StringVal(service))
+	body.SetAttributeValue("run_setup_tests", cty.False)
+
+	body.AppendNewline()
+	destinationSchema := body.AppendNewBlock("destination_schema", nil).Body()
+	if common.GetDestinationSchemaFields()[service]["schema_prefix"] {
+		destinationSchema.SetAttributeValue("prefix", cty.StringVal(resp.Data.Schema))
+	} else {
+		s := strings.Split(resp.Data.Schema, ".")
+		destinationSchema.SetAttributeValue("name", cty.StringVal(s[0]))
+		if len(s) > 1 && common.GetDestinationSchemaFields()[service]["table"] {
+			destinationSchema.SetAttributeValue("table", cty.StringVal(s[1]))
+		}
+	}
+
+	body.AppendNewline()
+	config := body.AppendNewBlock("config", nil).Body()
+	g.writeConfig(config, common.GetFieldsForService(service), resp.Data.Config, "connector_"+name)
+
+	g.addImport("fivetran_connector", name, resp.Data.ID)
+	return name
+}
+
+// addConnectorSchedule adds schedule resource without import block: the resource is synthetic and doesn't support import
+func (g *generator) addConnectorSchedule(connectorName string, resp connectors.DetailsWithCustomConfigNoTestsResponse) {
+	body := g.addResource("fivetran_connector_schedule", connectorName)
+	body.SetAttributeTraversal("connector_id", traversal("fivetran_connector", connectorName, "id"))
+	if resp.Data.SyncFrequency != nil {
+		body.SetAttributeValue("sync_frequency", cty.StringVal(helpers.IntPointerToStr(resp.Data.SyncFrequency)))
+	}
+	if resp.Data.DailySyncTime != "" {
+		body.SetAttributeValue("daily_sync_time", cty.StringVal(resp.Data.DailySyncTime))
+	}
+	body.SetAttributeValue("schedule_type", cty.StringVal(resp.Data.ScheduleType))
+	body.SetAttributeValue("paused", cty.StringVal(helpers.BoolPointerToStr(resp.Data.Paused)))
+	body.SetAttributeValue("pause_after_trial", cty.StringVal(helpers.BoolPointerToStr(resp.Data.PauseAfterTrial)))
+}
+
+func (g *generator) addConnectorSchemaConfig(connectorName, connectorId, schemaChangeHandling string, config configSchema.SchemaConfig) {
+	body := g.addResource("fivetran_connector_schema_config", connectorName)
+	body.SetAttributeTraversal("connector_id", traversal("fivetran_connector", connectorName, "id"))
+	body.SetAttributeValue("schema_change_handling", cty.StringVal(schemaChangeHandling))
+
+	// only elements that are not aligned with schema change handling policy are included
+	schemas := config.GetSchemas(schemaChangeHandling, configSchema.SchemaConfig{})
+	sortByName(schemas)
+	for _, s := range schemas {
+		sMap := s.(map[string]interface{})
+		body.AppendNewline()
+		schemaBody := body.AppendNewBlock("schema", nil).Body()
+		schemaBody.SetAttributeValue("name", cty.StringVal(sMap[configSchema.NAME].(string)))
+		schemaBody.SetAttributeValue("enabled", cty.BoolVal(helpers.StrToBool(sMap[configSchema.ENABLED].(string))))
+
+		tables := sMap[configSchema.TABLE].([]interface{})
+		sortByName(tables)
+		for _, t := range tables {
+			tMap := t.(map[string]interface{})
+			tableBody := schemaBody.AppendNewBlock("table", nil).Body()
+			tableBody.SetAttributeValue("name", cty.StringVal(tMap[configSchema.NAME].(string)))
+			tableBody.SetAttributeValue("enabled", cty.BoolVal(helpers.StrToBool(tMap[configSchema.ENABLED].(string))))
+
+			columns := tMap[configSchema.COLUMN].([]interface{})
+			sortByName(columns)
+			for _, c := range columns {
+				cMap := c.(map[string]interface{})
+				columnBody := tableBody.AppendNewBlock("column", nil).Body()
+				columnBody.SetAttributeValue("name", cty.StringVal(cMap[configSchema.NAME].(string)))
+				columnBody.SetAttributeValue("enabled", cty.BoolVal(helpers.StrToBool(cMap[configSchema.ENABLED].(string))))
+				if hashed, ok := cMap[configSchema.HASHED].(string); ok {
+					columnBody.SetAttributeValue("hashed", cty.BoolVal(helpers.StrToBool(hashed)))
+				}
+			}
+		}
+	}
+
+	g.addImport("fivetran_connector_schema_config", connectorName, connectorId)
+}
+
+func sortByName(items []interface{}) {
+	sort.Slice(items, func(i, j int) bool {
+		return items[i].(map[string]interface{})[configSchema.NAME].(string) < items[j].(map[string]interface{})[configSchema.NAME].(string)
+	})
+}
+
+// writeConfig writes config fields valid for the service, skipping read-only fields and fields without upstream values.
+// Top level object fields are written as blocks, nested ones as attributes - the same way as they are defined in resource schema.
+func (g *generator) writeConfig(body *hclwrite.Body, fields map[string]common.ConfigField, config map[string]interface{}, variablePrefix string) {
+	for _, fn := range sortedKeys(fields) {
+		field := fields[fn]
+		if field.Readonly {
+			continue
+		}
+		value, ok := config[apiFieldName(fn, field)]
+		if !ok || value == nil {
+			continue
+		}
+		switch field.FieldValueType {
+		case common.Object:
+			if vMap, ok := value.(map[string]interface{}); ok {
+				g.writeItemBlock(body.AppendNewBlock(fn, nil).Body(), field, vMap, variablePrefix+"_"+fn)
+			}
+		case common.ObjectList:
+			if items, ok := value.([]interface{}); ok {
+				for i, item := range items {
+					if vMap, ok := item.(map[string]interface{}); ok {
+						g.writeItemBlock(body.AppendNewBlock(fn, nil).Body(), field, vMap, fmt.Sprintf("%v_%v_%v", variablePrefix, fn, i))
+					}
+				}
+			}
+		default:
+			g.writeAttribute(body, fn, field, value, variablePrefix)
+		}
+	}
+}
+
+func (g *generator) writeItemBlock(body *hclwrite.Body, field common.ConfigField, value map[string]interface{}, variablePrefix string) {
+	for _, fn := range sortedKeys(field.ItemFields) {
+		itemField := field.ItemFields[fn]
+		if itemField.Readonly {
+			continue
+		}
+		itemValue, ok := value[apiFieldName(fn, itemField)]
+		if !ok || itemValue == nil {
+			continue
+		}
+		if itemField.FieldValueType == common.ObjectList {
+			if items, ok := itemValue.([]interface{}); ok {
+				for i, item := range items {
+					if vMap, ok := item.(map[string]interface{}); ok {
+						g.writeItemBlock(body.AppendNewBlock(fn, nil).Body(), itemField, vMap, fmt.Sprintf("%v_%v_%v", variablePrefix, fn, i))
+					}
+				}
+			}
+			continue
+		}
+		g.writeAttribute(body, fn, itemField, itemValue, variablePrefix)
+	}
+}
+
+// writeAttribute writes the value of the field. API returns masked values of sensitive fields, so sensitive fields
+// and attributes that contain them are written as references to the variables declared for them.
+func (g *generator) writeAttribute(body *hclwrite.Body, name string, field common.ConfigField, value interface{}, variablePrefix string) {
+	if hasSensitiveValues(field) {
+		body.SetAttributeTraversal(name, g.addSensitiveVariable(variablePrefix+"_"+name, field))
+		return
+	}
+	if v, ok := ctyValue(field, value); ok {
+		body.SetAttributeValue(name, v)
+	}
+}
+
+func ctyValue(field common.ConfigField, value interface{}) (cty.Value, bool) {
+	if value == nil {
+		return cty.NilVal, false
+	}
+	switch field.FieldValueType {
+	case common.String:
+		switch v := value.(type) {
+		case string:
+			return cty.StringVal(v), true
+		case float64:
+			// numeric API values of string fields (see ConfigField.ItemType)
+			return cty.StringVal(strconv.FormatFloat(v, 'f', -1, 64)), true
+		default:
+			return cty.StringVal(fmt.Sprintf("%v", v)), true
+		}
+	case common.Integer:
+		switch v := value.(type) {
+		case float64:
+			return cty.NumberIntVal(int64(v)), true
+		case string:
+			if i, err := strconv.Atoi(v); err == nil {
+				return cty.NumberIntVal(int64(i)), true
+			}
+		}
+	case common.Boolean:
+		switch v := value.(type) {
+		case bool:
+			return cty.BoolVal(v), true
+		case string:
+			if v != "" {
+				return cty.BoolVal(helpers.StrToBool(v)), true
+			}
+		}
+	case common.StringList:
+		if items, ok := value.([]interface{}); ok {
+			values := make([]cty.Value, 0, len(items))
+			for _, item := range items {
+				if v, ok := ctyValue(common.ConfigField{FieldValueType: common.String}, item); ok {
+					values = append(values, v)
+				}
+			}
+			return cty.TupleVal(values), true
+		}
+	case common.ObjectList:
+		if items, ok := value.([]interface{}); ok {
+			values := make([]cty.Value, 0, len(items))
+			for _, item := range items {
+				if v, ok := ctyValue(common.ConfigField{FieldValueType: common.Object, ItemFields: field.ItemFields}, item); ok {
+					values = append(values, v)
+				}
+			}
+			return cty.TupleVal(values), true
+		}
+	case common.Object:
+		if vMap, ok := value.(map[string]interface{}); ok {
+			attrs := make(map[string]cty.Value)
+			for fn, f := range field.ItemFields {
+				if f.Readonly {
+					continue
+				}
+				if v, ok := ctyValue(f, vMap[apiFieldName(fn, f)]); ok {
+					attrs[fn] = v
+				}
+			}
+			return cty.ObjectVal(attrs), true
+		}
+	}
+	return cty.NilVal, false
+}
+
+func apiFieldName(name string, field common.ConfigField) string {
+	if field.ApiField != "" {
+		return field.ApiField
+	}
+	return name
+}
+
+func sortedKeys(fields map[string]common.ConfigField) []string {
+	result := make([]string, 0, len(fields))
+	for k := range fields {
+		result = append(result, k)
+	}
+	sort.Strings(result)
+	return result
+}
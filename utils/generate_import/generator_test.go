@@ -0,0 +1,150 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/fivetran/go-fivetran/connectors"
+	"github.com/fivetran/go-fivetran/destinations"
+	"github.com/fivetran/go-fivetran/groups"
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/common"
+	configSchema "github.com/fivetran/terraform-provider-fivetran/modules/connector/schema"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+)
+
+var update = flag.Bool("update", false, "update golden files")
+
+func init() {
+	common.LoadConfigFieldsMap()
+	common.LoadAuthFieldsMap()
+	common.LocaDestinationFieldsMap()
+}
+
+// account is the test input: API responses of the account the configuration is generated for
+type account struct {
+	Groups       []groups.GroupItem                                             `json:"groups"`
+	Destinations map[string]destinations.DestinationDetailsCustomResponse       `json:"destinations"`
+	Connectors   map[string][]connectors.DetailsWithCustomConfigNoTestsResponse `json:"connectors"`
+	Schemas      map[string]connectors.ConnectorSchemaDetailsResponse           `json:"schemas"`
+}
+
+func assertGolden(t *testing.T, name string, actual []byte) {
+	t.Helper()
+	golden := filepath.Join("testdata", name+".golden")
+	if *update {
+		if err := os.WriteFile(golden, actual, 0644); err != nil {
+			t.Fatalf("unable to update golden file: %v", err)
+		}
+	}
+	expected, err := os.ReadFile(golden)
+	if err != nil {
+		t.Fatalf("unable to read golden file: %v", err)
+	}
+	if string(expected) != string(actual) {
+		t.Errorf("generated configuration doesn't match %v (run with -update to update it):\n%s", golden, actual)
+	}
+}
+
+func TestGenerateAccount(t *testing.T) {
+	content, err := os.ReadFile(filepath.Join("testdata", "account.json"))
+	if err != nil {
+		t.Fatalf("unable to read test input: %v", err)
+	}
+	input := account{}
+	if err := json.Unmarshal(content, &input); err != nil {
+		t.Fatalf("unable to parse test input: %v", err)
+	}
+
+	// the same sequence of calls as in main
+	g := newGenerator()
+	for _, group := range input.Groups {
+		groupName := g.addGroup(group)
+		if destination, ok := input.Destinations[group.ID]; ok {
+			g.addDestination(groupName, destination)
+		}
+		for _, connector := range input.Connectors[group.ID] {
+			connectorName := g.addConnector(groupName, connector)
+			g.addConnectorSchedule(connectorName, connector)
+			if schemaResp, ok := input.Schemas[connector.Data.ID]; ok {
+				schemaConfig := configSchema.SchemaConfig{}
+				schemaConfig.ReadFromResponse(schemaResp)
+				g.addConnectorSchemaConfig(connectorName, connector.Data.ID, schemaResp.Data.SchemaChangeHandling, schemaConfig)
+			}
+		}
+	}
+
+	assertGolden(t, "account", g.bytes())
+}
+
+func TestGenerateSensitiveConfig(t *testing.T) {
+	sensitiveString := common.ConfigField{FieldValueType: common.String, Sensitive: true}
+	fields := map[string]common.ConfigField{
+		"plain":         {FieldValueType: common.String},
+		"secret":        sensitiveString,
+		"secret_number": {FieldValueType: common.Integer, Sensitive: true},
+		"secret_flag":   {FieldValueType: common.Boolean, Sensitive: true},
+		"secret_list":   {FieldValueType: common.StringList, Sensitive: true},
+		"read_only":     {FieldValueType: common.String, Sensitive: true, Readonly: true},
+		"object_secrets": {
+			FieldValueType: common.Object,
+			ItemFields: map[string]common.ConfigField{
+				"name":  {FieldValueType: common.String},
+				"value": sensitiveString,
+				"nested": {
+					FieldValueType: common.Object,
+					ItemFields: map[string]common.ConfigField{
+						"token": sensitiveString,
+					},
+				},
+			},
+		},
+		"list_secrets": {
+			FieldValueType: common.ObjectList,
+			ItemFields: map[string]common.ConfigField{
+				"name":  {FieldValueType: common.String},
+				"value": sensitiveString,
+			},
+		},
+	}
+	config := map[string]interface{}{
+		"plain":         "value",
+		"secret":        "******",
+		"secret_number": "******",
+		"secret_flag":   "******",
+		"secret_list":   []interface{}{"******"},
+		"read_only":     "******",
+		"object_secrets": map[string]interface{}{
+			"name":   "name",
+			"value":  "******",
+			"nested": map[string]interface{}{"token": "******"},
+		},
+		"list_secrets": []interface{}{
+			map[string]interface{}{"name": "first", "value": "******"},
+			map[string]interface{}{"name": "second", "value": "******"},
+		},
+	}
+
+	g := newGenerator()
+	body := g.file.Body().AppendNewBlock("config", nil).Body()
+	g.writeConfig(body, fields, config, "connector")
+
+	assertGolden(t, "sensitive_config", g.bytes())
+}
+
+func TestVariableType(t *testing.T) {
+	for fieldType, expected := range map[common.FieldValueType]string{
+		common.String:     "string",
+		common.Integer:    "number",
+		common.Boolean:    "bool",
+		common.StringList: "list(string)",
+		common.Object:     "any",
+		common.ObjectList: "any",
+	} {
+		if actual := string(hclwrite.Format(variableType(common.ConfigField{FieldValueType: fieldType}).Bytes())); actual != expected {
+			t.Errorf("expected type %v for %v, got %v", expected, fieldType, actual)
+		}
+	}
+}
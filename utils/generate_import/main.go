@@ -0,0 +1,170 @@
+package main
+
+// Generates Terraform configuration with `import` blocks for the groups, destinations, connectors,
+// connector schedules and connector schema configs that already exist in the Fivetran account.
+//
+// Usage:
+//
+//	FIVETRAN_APIKEY=<key> FIVETRAN_APISECRET=<secret> go run ./utils/generate_import -out imported.tf
+//
+// Flags:
+//
+//	-out     output file name, the configuration is printed to stdout if not set
+//	-group   generate configuration only for the specified group
+//	-schemas generate `fivetran_connector_schema_config` resources (true by default)
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/fivetran/go-fivetran"
+	"github.com/fivetran/go-fivetran/connectors"
+	"github.com/fivetran/go-fivetran/groups"
+	"github.com/fivetran/terraform-provider-fivetran/fivetran/common"
+	configSchema "github.com/fivetran/terraform-provider-fivetran/modules/connector/schema"
+)
+
+const limit = 1000 // REST API response objects limit per HTTP request
+
+func main() {
+	out := flag.String("out", "", "output file name, stdout if not set")
+	groupId := flag.String("group", "", "generate configuration only for the specified group")
+	includeSchemas := flag.Bool("schemas", true, "generate fivetran_connector_schema_config resources")
+	flag.Parse()
+
+	apiKey := os.Getenv("FIVETRAN_APIKEY")
+	apiSecret := os.Getenv("FIVETRAN_APISECRET")
+	if apiKey == "" || apiSecret == "" {
+		log.Fatal("FIVETRAN_APIKEY and FIVETRAN_APISECRET environment variables should be set")
+	}
+
+	client := fivetran.New(apiKey, apiSecret)
+	if apiUrl := os.Getenv("FIVETRAN_API_URL"); apiUrl != "" {
+		client.BaseURL(apiUrl)
+	}
+	client.CustomUserAgent("terraform-provider-fivetran/import-generator")
+
+	common.LoadConfigFieldsMap()
+	common.LoadAuthFieldsMap()
+	common.LocaDestinationFieldsMap()
+
+	ctx := context.Background()
+	generator := newGenerator()
+
+	groupItems, err := listGroups(ctx, client)
+	if err != nil {
+		log.Fatalf("Unable to list groups: %v", err)
+	}
+
+	for _, group := range groupItems {
+		if *groupId != "" && group.ID != *groupId {
+			continue
+		}
+		log.Printf("Reading group %v (%v)...", group.Name, group.ID)
+		groupName := generator.addGroup(group)
+
+		destinationResp, err := client.NewDestinationDetails().DestinationID(group.ID).DoCustom(ctx)
+		if err != nil {
+			// group could exist without destination
+			if destinationResp.Code != "NotFound_Destination" {
+				log.Fatalf("Unable to read destination %v: %v; code: %v; message: %v", group.ID, err, destinationResp.Code, destinationResp.Message)
+			}
+		} else {
+			generator.addDestination(groupName, destinationResp)
+		}
+
+		connectorItems, err := listGroupConnectors(ctx, client, group.ID)
+		if err != nil {
+			log.Fatalf("Unable to list connectors of group %v: %v", group.ID, err)
+		}
+
+		for _, item := range connectorItems {
+			connectorResp, err := client.NewConnectorDetails().ConnectorID(item.ID).DoCustom(ctx)
+			if err != nil {
+				log.Fatalf("Unable to read connector %v: %v; code: %v; message: %v", item.ID, err, connectorResp.Code, connectorResp.Message)
+			}
+			connectorName := generator.addConnector(groupName, connectorResp)
+			generator.addConnectorSchedule(connectorName, connectorResp)
+
+			if *includeSchemas {
+				schemaResp, err := client.NewConnectorSchemaDetails().ConnectorID(item.ID).Do(ctx)
+				if err != nil {
+					// schema config isn't initialized for the connector yet
+					if schemaResp.Code != "NotFound_SchemaConfig" {
+						log.Fatalf("Unable to read schema config of connector %v: %v; code: %v; message: %v", item.ID, err, schemaResp.Code, schemaResp.Message)
+					}
+					continue
+				}
+				schemaConfig := configSchema.SchemaConfig{}
+				schemaConfig.ReadFromResponse(schemaResp)
+				generator.addConnectorSchemaConfig(connectorName, item.ID, schemaResp.Data.SchemaChangeHandling, schemaConfig)
+			}
+		}
+	}
+
+	result := generator.bytes()
+
+	if *out == "" {
+		fmt.Print(string(result))
+		return
+	}
+	if err := os.WriteFile(*out, result, 0644); err != nil {
+		log.Fatalf("Unable to write %v: %v", *out, err)
+	}
+	log.Printf("Configuration saved to %v", *out)
+}
+
+// listGroups gets the groups list. It handles limits and cursors.
+func listGroups(ctx context.Context, client *fivetran.Client) ([]groups.GroupItem, error) {
+	var result []groups.GroupItem
+	var cursor string
+
+	for {
+		svc := client.NewGroupsList().Limit(limit)
+		if cursor != "" {
+			svc.Cursor(cursor)
+		}
+		resp, err := svc.Do(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("%v; code: %v; message: %v", err, resp.Code, resp.Message)
+		}
+
+		result = append(result, resp.Data.Items...)
+
+		if resp.Data.NextCursor == "" {
+			break
+		}
+		cursor = resp.Data.NextCursor
+	}
+
+	return result, nil
+}
+
+// listGroupConnectors gets the connectors list of a group. It handles limits and cursors.
+func listGroupConnectors(ctx context.Context, client *fivetran.Client, groupId string) ([]connectors.DetailsResponseDataCommon, error) {
+	var result []connectors.DetailsResponseDataCommon
+	var cursor string
+
+	for {
+		svc := client.NewGroupListConnectors().GroupID(groupId).Limit(limit)
+		if cursor != "" {
+			svc.Cursor(cursor)
+		}
+		resp, err := svc.Do(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("%v; code: %v; message: %v", err, resp.Code, resp.Message)
+		}
+
+		result = append(result, resp.Data.Items...)
+
+		if resp.Data.NextCursor == "" {
+			break
+		}
+		cursor = resp.Data.NextCursor
+	}
+
+	return result, nil
+}